@@ -53,6 +53,35 @@ type Diff struct {
 type BreakingChange struct {
 	Message                string
 	DocumentationReference string
+	Severity               string
+	// Exemption is set for changes acknowledged by an exemption.
+	Exemption *BreakingChangeExemption
+}
+
+type BreakingChangeExemption struct {
+	Reason   string
+	Approver string
+}
+
+const (
+	// informationalSeverity is the severity of diff-processor findings that don't block merging.
+	informationalSeverity = "informational"
+	// acknowledgedSeverity is the severity of breaking changes a reviewer exempted.
+	acknowledgedSeverity = "acknowledged"
+)
+
+// breakingChangeExemptionsFile lists the reviewed breaking changes, relative to the
+// magic-modules repository.
+const breakingChangeExemptionsFile = "tools/diff-processor/breaking_change_exemptions.yaml"
+
+// staleExemptionsError is returned along with the breaking changes when the
+// exemptions file has stale exemptions, which must be removed.
+type staleExemptionsError struct {
+	err error
+}
+
+func (e *staleExemptionsError) Error() string {
+	return e.err.Error()
 }
 
 type MissingTestInfo struct {
@@ -69,6 +98,8 @@ type diffCommentData struct {
 	PrNumber             int
 	Diffs                []Diff
 	BreakingChanges      []BreakingChange
+	InformationalChanges []BreakingChange
+	AcknowledgedChanges  []BreakingChange
 	MissingServiceLabels []string
 	MissingTests         map[string]*MissingTestInfo
 	Errors               []Errors
@@ -82,6 +113,7 @@ const allowBreakingChangesLabel = "override-breaking-change"
 const allowMissingServiceLabelsLabel = "override-missing-service-labels"
 
 var gcEnvironmentVariables = [...]string{
+	"BASE_BRANCH",
 	"BUILD_ID",
 	"BUILD_STEP",
 	"COMMIT_SHA",
@@ -137,6 +169,7 @@ var generateCommentCmd = &cobra.Command{
 		}
 		return execGenerateComment(
 			prNumber,
+			env["BASE_BRANCH"],
 			env["GITHUB_TOKEN_MAGIC_MODULES"],
 			env["BUILD_ID"],
 			env["BUILD_STEP"],
@@ -157,7 +190,7 @@ func listGCEnvironmentVariables() string {
 	return result
 }
 
-func execGenerateComment(prNumber int, ghTokenMagicModules, buildId, buildStep, projectId, commitSha string, gh GithubClient, rnr ExecRunner, ctlr *source.Controller) error {
+func execGenerateComment(prNumber int, baseBranch, ghTokenMagicModules, buildId, buildStep, projectId, commitSha string, gh GithubClient, rnr ExecRunner, ctlr *source.Controller) error {
 	errors := map[string][]string{"Other": []string{}}
 
 	// TODO(ScottSuarez) - temporary fix to ensure the label is removed.
@@ -269,6 +302,15 @@ func execGenerateComment(prNumber int, ghTokenMagicModules, buildId, buildStep,
 	uniqueAffectedResources := map[string]struct{}{}
 	uniqueBreakingChanges := map[string]BreakingChange{}
 	diffProcessorPath := filepath.Join(mmLocalPath, "tools", "diff-processor")
+	if baseBranch == "" {
+		baseBranch = "main"
+	}
+	exemptionsFile, err := writeBaseBranchExemptions(mmLocalPath, baseBranch, rnr)
+	if err != nil {
+		fmt.Println("reading breaking change exemptions: ", err)
+		errors["Other"] = append(errors["Other"], "Failed to read the breaking change exemptions of the base branch")
+	}
+	staleExemptions := false
 	diffProcessorEnv := map[string]string{
 		"OLD_REF": oldBranch,
 		"NEW_REF": newBranch,
@@ -293,8 +335,11 @@ func execGenerateComment(prNumber int, ghTokenMagicModules, buildId, buildStep,
 			continue
 		}
 
-		breakingChanges, err := computeBreakingChanges(diffProcessorPath, rnr)
-		if err != nil {
+		breakingChanges, err := computeBreakingChanges(diffProcessorPath, exemptionsFile, rnr)
+		if _, ok := err.(*staleExemptionsError); ok {
+			fmt.Println("checking breaking change exemptions: ", err)
+			staleExemptions = true
+		} else if err != nil {
 			fmt.Println("computing breaking changes: ", err)
 			errors[repo.Title] = append(errors[repo.Title], "The diff processor crashed while computing breaking changes. This is usually due to the downstream provider failing to compile.")
 		}
@@ -325,11 +370,23 @@ func execGenerateComment(prNumber int, ghTokenMagicModules, buildId, buildStep,
 			uniqueAffectedResources[resource] = struct{}{}
 		}
 	}
+	if staleExemptions {
+		errors["Other"] = append(errors["Other"], "The base branch has stale breaking change exemptions in `"+breakingChangeExemptionsFile+"`, which must be removed.")
+	}
 	breakingChangesSlice := maps.Values(uniqueBreakingChanges)
 	sort.Slice(breakingChangesSlice, func(i, j int) bool {
 		return breakingChangesSlice[i].Message < breakingChangesSlice[j].Message
 	})
-	data.BreakingChanges = breakingChangesSlice
+	for _, breakingChange := range breakingChangesSlice {
+		switch breakingChange.Severity {
+		case informationalSeverity:
+			data.InformationalChanges = append(data.InformationalChanges, breakingChange)
+		case acknowledgedSeverity:
+			data.AcknowledgedChanges = append(data.AcknowledgedChanges, breakingChange)
+		default:
+			data.BreakingChanges = append(data.BreakingChanges, breakingChange)
+		}
+	}
 
 	// Compute affected resources based on changed files
 	changedFilesAffectedResources := map[string]struct{}{}
@@ -385,7 +442,7 @@ func execGenerateComment(prNumber int, ghTokenMagicModules, buildId, buildStep,
 
 	// Update breaking changes status on PR
 	breakingState := "success"
-	if len(uniqueBreakingChanges) > 0 {
+	if len(data.BreakingChanges) > 0 {
 		breakingState = "failure"
 		// If fetching the PR failed, Labels will be empty
 		for _, label := range pullRequest.Labels {
@@ -395,6 +452,10 @@ func execGenerateComment(prNumber int, ghTokenMagicModules, buildId, buildStep,
 			}
 		}
 	}
+	// Stale exemptions must be removed whatever the labels of the PR.
+	if staleExemptions {
+		breakingState = "failure"
+	}
 	targetURL := fmt.Sprintf("https://console.cloud.google.com/cloud-build/builds;region=global/%s;step=%s?project=%s", buildId, buildStep, projectId)
 	if err = gh.PostBuildStatus(strconv.Itoa(prNumber), "terraform-provider-breaking-change-test", breakingState, targetURL, commitSha); err != nil {
 		fmt.Printf("Error posting terraform-provider-breaking-change-test build status for pr %d commit %s: %v\n", prNumber, commitSha, err)
@@ -486,24 +547,62 @@ func buildDiffProcessor(diffProcessorPath, providerLocalPath string, env map[str
 	return rnr.PopDir()
 }
 
-func computeBreakingChanges(diffProcessorPath string, rnr ExecRunner) ([]BreakingChange, error) {
+// writeBaseBranchExemptions writes the breaking change exemptions of the base branch
+// next to the magic-modules checkout, and returns the path of the file, or "" if the
+// base branch has none. They're read from the base branch so that a PR can't exempt
+// its own breaking changes.
+func writeBaseBranchExemptions(mmLocalPath, baseBranch string, rnr ExecRunner) (string, error) {
+	if err := rnr.PushDir(mmLocalPath); err != nil {
+		return "", err
+	}
+	defer rnr.PopDir()
+	ref := "origin/" + baseBranch
+	// ls-tree prints nothing if the base branch has no exemptions file.
+	listed, err := rnr.Run("git", []string{"ls-tree", "--name-only", ref, "--", breakingChangeExemptionsFile}, nil)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(listed) == "" {
+		return "", nil
+	}
+	exemptions, err := rnr.Run("git", []string{"show", ref + ":" + breakingChangeExemptionsFile}, nil)
+	if err != nil {
+		return "", err
+	}
+	exemptionsFile := filepath.Join(mmLocalPath, "..", "breaking_change_exemptions.yaml")
+	if err := rnr.WriteFile(exemptionsFile, exemptions); err != nil {
+		return "", err
+	}
+	return exemptionsFile, nil
+}
+
+func computeBreakingChanges(diffProcessorPath, exemptionsFile string, rnr ExecRunner) ([]BreakingChange, error) {
 	if err := rnr.PushDir(diffProcessorPath); err != nil {
 		return nil, err
 	}
-	output, err := rnr.Run("bin/diff-processor", []string{"breaking-changes"}, nil)
-	if err != nil {
+	args := []string{"breaking-changes"}
+	if exemptionsFile != "" {
+		args = append(args, "--exemptions-file="+exemptionsFile)
+	}
+	output, runErr := rnr.Run("bin/diff-processor", args, nil)
+	if err := rnr.PopDir(); err != nil {
 		return nil, err
 	}
-
-	if output == "" {
-		return nil, nil
+	// breaking-changes still prints the changes when it fails on stale exemptions.
+	if runErr != nil && output == "" {
+		return nil, runErr
 	}
 
 	var changes []BreakingChange
-	if err = json.Unmarshal([]byte(output), &changes); err != nil {
-		return nil, err
+	if output != "" {
+		if err := json.Unmarshal([]byte(output), &changes); err != nil {
+			return nil, err
+		}
+	}
+	if runErr != nil {
+		return changes, &staleExemptionsError{err: runErr}
 	}
-	return changes, rnr.PopDir()
+	return changes, nil
 }
 
 func computeAffectedResources(diffProcessorPath string, rnr ExecRunner, repo source.Repo) (simpleSchemaDiff, error) {
@@ -16,6 +16,7 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 	"reflect"
 	"testing"
@@ -40,6 +41,7 @@ func TestExecGenerateComment(t *testing.T) {
 	}
 	execGenerateComment(
 		123456,
+		"main",
 		"*******",
 		"build1",
 		"17",
@@ -87,11 +89,13 @@ func TestExecGenerateComment(t *testing.T) {
 			{"/mock/dir/tgc", "git", []string{"diff", "origin/auto-pr-123456-old", "origin/auto-pr-123456", "--shortstat"}, map[string]string(nil)},
 			{"/mock/dir/tgc", "git", []string{"diff", "origin/auto-pr-123456-old", "origin/auto-pr-123456", "--name-only"}, map[string]string(nil)},
 			{"/mock/dir/tfoics", "git", []string{"diff", "origin/auto-pr-123456-old", "origin/auto-pr-123456", "--shortstat"}, map[string]string(nil)},
+			{"/mock/dir/magic-modules", "git", []string{"ls-tree", "--name-only", "origin/main", "--", "tools/diff-processor/breaking_change_exemptions.yaml"}, map[string]string(nil)},
+			{"/mock/dir/magic-modules", "git", []string{"show", "origin/main:tools/diff-processor/breaking_change_exemptions.yaml"}, map[string]string(nil)},
 			{"/mock/dir/magic-modules/tools/diff-processor", "make", []string{"build"}, diffProcessorEnv},
-			{"/mock/dir/magic-modules/tools/diff-processor", "bin/diff-processor", []string{"breaking-changes"}, map[string]string(nil)},
+			{"/mock/dir/magic-modules/tools/diff-processor", "bin/diff-processor", []string{"breaking-changes", "--exemptions-file=/mock/dir/breaking_change_exemptions.yaml"}, map[string]string(nil)},
 			{"/mock/dir/magic-modules/tools/diff-processor", "bin/diff-processor", []string{"schema-diff"}, map[string]string(nil)},
 			{"/mock/dir/magic-modules/tools/diff-processor", "make", []string{"build"}, diffProcessorEnv},
-			{"/mock/dir/magic-modules/tools/diff-processor", "bin/diff-processor", []string{"breaking-changes"}, map[string]string(nil)},
+			{"/mock/dir/magic-modules/tools/diff-processor", "bin/diff-processor", []string{"breaking-changes", "--exemptions-file=/mock/dir/breaking_change_exemptions.yaml"}, map[string]string(nil)},
 			{"/mock/dir/magic-modules/tools/diff-processor", "bin/diff-processor", []string{"detect-missing-tests", "/mock/dir/tpgb/google-beta/services"}, map[string]string(nil)},
 			{"/mock/dir/magic-modules/tools/diff-processor", "bin/diff-processor", []string{"schema-diff"}, map[string]string(nil)},
 		},
@@ -131,6 +135,61 @@ func TestExecGenerateComment(t *testing.T) {
 	}
 }
 
+func TestComputeBreakingChanges(t *testing.T) {
+	const breakingChangesCmd = "/mock/dir/magic-modules/tools/diff-processor bin/diff-processor [breaking-changes --exemptions-file=/mock/dir/exemptions.yaml] map[]"
+	const output = `[{"Message":"Field ` + "`a`" + ` was removed","Severity":"acknowledged","Exemption":{"Reason":"Replaced by b","Approver":"reviewer"}}]`
+	want := []BreakingChange{
+		{
+			Message:  "Field `a` was removed",
+			Severity: "acknowledged",
+			Exemption: &BreakingChangeExemption{
+				Reason:   "Replaced by b",
+				Approver: "reviewer",
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		output         string
+		err            error
+		want           []BreakingChange
+		wantErr        bool
+		wantStaleError bool
+	}{
+		"breaking changes": {
+			output: output,
+			want:   want,
+		},
+		"stale exemptions": {
+			output:         output,
+			err:            fmt.Errorf("stale exemptions"),
+			want:           want,
+			wantErr:        true,
+			wantStaleError: true,
+		},
+		"crash": {
+			err:     fmt.Errorf("panic"),
+			wantErr: true,
+		},
+	}
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			mr := NewMockRunner().(*mockRunner)
+			mr.cmdResults[breakingChangesCmd] = tc.output
+			mr.cmdErrors = map[string]error{breakingChangesCmd: tc.err}
+
+			got, err := computeBreakingChanges("/mock/dir/magic-modules/tools/diff-processor", "/mock/dir/exemptions.yaml", mr)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("computeBreakingChanges() error = %v, want error %v", err, tc.wantErr)
+			}
+			if _, ok := err.(*staleExemptionsError); ok != tc.wantStaleError {
+				t.Errorf("computeBreakingChanges() error = %v, want stale exemptions error %v", err, tc.wantStaleError)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestFormatDiffComment(t *testing.T) {
 	cases := map[string]struct {
 		data               diffCommentData
@@ -195,6 +254,47 @@ func TestFormatDiffComment(t *testing.T) {
 				"## Missing test report",
 			},
 		},
+		"informational changes are displayed": {
+			data: diffCommentData{
+				InformationalChanges: []BreakingChange{
+					{
+						Message:                "Resource `google-x` was deprecated",
+						DocumentationReference: "doc1",
+						Severity:               "informational",
+					},
+				},
+			},
+			expectedStrings: []string{
+				"## Heads-up",
+				"- Resource `google-x` was deprecated - [reference](doc1)",
+			},
+			notExpectedStrings: []string{
+				"## Breaking Change(s) Detected",
+			},
+		},
+		"acknowledged changes are displayed": {
+			data: diffCommentData{
+				AcknowledgedChanges: []BreakingChange{
+					{
+						Message:                "Field `foo` within resource `google-x` was removed",
+						DocumentationReference: "doc1",
+						Severity:               "acknowledged",
+						Exemption: &BreakingChangeExemption{
+							Reason:   "Removed in favor of `bar`",
+							Approver: "reviewer",
+						},
+					},
+				},
+			},
+			expectedStrings: []string{
+				"## Acknowledged Breaking Change(s)",
+				"- Field `foo` within resource `google-x` was removed - [reference](doc1) - Removed in favor of `bar` (approved by @reviewer)",
+			},
+			notExpectedStrings: []string{
+				"## Breaking Change(s) Detected",
+				"## Heads-up",
+			},
+		},
 		"breaking changes are displayed": {
 			data: diffCommentData{
 				BreakingChanges: []BreakingChange{
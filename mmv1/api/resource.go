@@ -141,6 +141,17 @@ type Resource struct {
 	// the decoder will be included within the code handling the nested query.
 	NestedQuery *resource.NestedQuery `yaml:"nested_query,omitempty"`
 
+	// [Optional] (Api::Resource::Batching) If set, create requests are
+	// combined with other creates sharing the same batch key and sent to a
+	// batch endpoint, using the provider's request batcher.
+	Batching *resource.Batching `yaml:"batching,omitempty"`
+
+	// [Optional] The name of a top-level output property holding the
+	// resource's etag, usually `etag`. If set, updates and deletes send the
+	// etag last read into state and, when the API reports a concurrent
+	// modification (412 or ABORTED), re-read the etag and retry.
+	EtagField string `yaml:"etag_field,omitempty"`
+
 	// ====================
 	// IAM Configuration
 	// ====================
@@ -222,9 +233,16 @@ type Resource struct {
 	ExcludeImport bool `yaml:"exclude_import,omitempty"`
 
 	// If true, exclude resource from Terraform Validator
-	// (i.e. terraform-provider-conversion)
+	// (i.e. terraform-provider-conversion), including the generated
+	// cai2hcl converter
 	ExcludeTgc bool `yaml:"exclude_tgc,omitempty"`
 
+	// Terraform fields that are known to be lost when converting the resource
+	// to a CAI asset and back to HCL, which are ignored by the generated tgc
+	// round-trip tests. Nested fields are separated by dots, without list
+	// indexes (e.g. settings.tier).
+	TgcLossyFields []string `yaml:"tgc_lossy_fields,omitempty"`
+
 	// If true, skip sweeper generation for this resource
 	ExcludeSweeper bool `yaml:"exclude_sweeper,omitempty"`
 
@@ -252,6 +270,13 @@ type Resource struct {
 
 	StateUpgraders bool `yaml:"state_upgraders,omitempty"`
 
+	// Declarative state upgrades for mechanical schema changes, keyed by the
+	// schema version they upgrade from. Each entry generates the state_upgrader
+	// function and its unit test for that version, in place of a handwritten
+	// file under mmv1/templates/terraform/state_migrations/.
+	// Versions without an entry still use the handwritten file.
+	StateMigrations []resource.StateMigration `yaml:"state_migrations,omitempty"`
+
 	// Do not apply the default attribution label
 	ExcludeAttributionLabel bool `yaml:"exclude_attribution_label,omitempty"`
 
@@ -266,6 +291,16 @@ type Resource struct {
 	// level resources such as firebase project
 	ExcludeDelete bool `yaml:"exclude_delete,omitempty"`
 
+	// Set to true for singleton resources such as settings or config objects,
+	// which always exist and cannot be created or deleted. Creating the
+	// resource applies its configuration as an update, and unless
+	// restore_defaults_on_delete is set, deleting it only removes it from state.
+	Singleton bool `yaml:"singleton,omitempty"`
+
+	// If true, deleting a singleton resource sends an update restoring each
+	// top-level field with a `default_value` to that value.
+	RestoreDefaultsOnDelete bool `yaml:"restore_defaults_on_delete,omitempty"`
+
 	// Set to true for resources that are unable to be read from the API, such as
 	// public ca external account keys
 	ExcludeRead bool `yaml:"exclude_read,omitempty"`
@@ -400,7 +435,12 @@ func (r *Resource) SetDefault(product *Product) {
 	if r.Timeouts == nil {
 		r.Timeouts = NewTimeouts()
 	}
-
+	if len(r.StateMigrations) > 0 {
+		r.StateUpgraders = true
+	}
+	if r.Batching != nil {
+		r.Batching.SetDefault()
+	}
 }
 
 func (r *Resource) Validate() {
@@ -464,6 +504,46 @@ func (r *Resource) Validate() {
 		r.NestedQuery.Validate(r.Name)
 	}
 
+	if r.Batching != nil {
+		r.Batching.Validate(r.Name)
+		if r.Mutex != "" {
+			log.Fatalf("`batching` cannot be combined with `mutex` in resource %s", r.Name)
+		}
+		if r.CustomCode.CustomCreate != "" || (r.NestedQuery != nil && r.NestedQuery.ModifyByPatch) {
+			log.Fatalf("`batching` cannot be combined with a custom or patch-based create in resource %s", r.Name)
+		}
+	}
+
+	if r.Singleton {
+		if r.Immutable {
+			log.Fatalf("`singleton` resource %s must be updatable", r.Name)
+		}
+		if r.CustomCode.CustomCreate != "" || r.Batching != nil {
+			log.Fatalf("`singleton` cannot be combined with a custom or batched create in resource %s", r.Name)
+		}
+	}
+
+	if r.RestoreDefaultsOnDelete {
+		if !r.Singleton {
+			log.Fatalf("`restore_defaults_on_delete` requires `singleton` in resource %s", r.Name)
+		}
+		if r.ExcludeDelete || r.CustomCode.CustomDelete != "" {
+			log.Fatalf("`restore_defaults_on_delete` cannot be combined with `exclude_delete` or a custom delete in resource %s", r.Name)
+		}
+		if len(r.SingletonDefaultProperties()) == 0 {
+			log.Fatalf("`restore_defaults_on_delete` requires at least one updatable property with a `default_value` in resource %s", r.Name)
+		}
+	}
+
+	if r.EtagField != "" {
+		if r.EtagProperty() == nil {
+			log.Fatalf("Missing property %s for `etag_field` in resource %s", r.EtagField, r.Name)
+		}
+		if r.NestedQuery != nil {
+			log.Fatalf("`etag_field` cannot be combined with `nested_query` in resource %s", r.Name)
+		}
+	}
+
 	for _, example := range r.Examples {
 		example.Validate(r.Name)
 	}
@@ -471,6 +551,27 @@ func (r *Resource) Validate() {
 	if r.Async != nil {
 		r.Async.Validate()
 	}
+
+	var migrationVersions []int
+	for _, migration := range r.StateMigrations {
+		if slices.Contains(migrationVersions, migration.Version) {
+			log.Fatalf("Duplicate `state_migrations` version %d in resource %s", migration.Version, r.Name)
+		}
+		migrationVersions = append(migrationVersions, migration.Version)
+		migration.Validate(r.Name, r.StateUpgradeBaseSchemaVersion, r.SchemaVersion)
+	}
+	for _, version := range migrationVersions {
+		// The prior schema of a declarative migration is derived from the
+		// current schema, so every later version must be declarative too.
+		for v := version + 1; v < r.SchemaVersion; v++ {
+			if !slices.Contains(migrationVersions, v) {
+				log.Fatalf("`state_migrations` version %d in resource %s needs a `state_migrations` entry for every later version, but version %d is missing", version, r.Name, v)
+			}
+		}
+	}
+	if len(r.StateMigrations) > 0 && r.CustomCode.ExtraSchemaEntry != "" {
+		log.Fatalf("`state_migrations` can't be used with `custom_code.extra_schema_entry` in resource %s, as the prior schema can't include custom fields", r.Name)
+	}
 }
 
 // ====================
@@ -611,6 +712,50 @@ func (r Resource) SettableProperties() []*Type {
 	return props
 }
 
+// Returns the top-level properties restored to their `default_value` when a
+// singleton resource is deleted.
+func (r Resource) SingletonDefaultProperties() []*Type {
+	return google.Select(r.UpdateBodyProperties(), func(p *Type) bool {
+		return p.DefaultValue != nil
+	})
+}
+
+// Returns the update mask covering the fields restored when a singleton
+// resource is deleted.
+func (r Resource) SingletonDefaultsUpdateMask() string {
+	var fields []string
+	for _, p := range r.SingletonDefaultProperties() {
+		fields = append(fields, p.ApiName)
+	}
+	return strings.Join(fields, ",")
+}
+
+// Whether deleting the resource only removes it from state, leaving it
+// unchanged on Google Cloud.
+func (r Resource) AbandonOnDelete() bool {
+	return r.ExcludeDelete || (r.Singleton && !r.RestoreDefaultsOnDelete)
+}
+
+// Whether generated tests skip checking that the resource is gone after
+// destroy, as resources that can't be deleted still exist.
+func (r Resource) SkipDestroyCheck() bool {
+	return r.ExcludeDelete || r.Singleton
+}
+
+// Returns the top-level property named by `etag_field`, or nil if it is unset
+// or doesn't match a property.
+func (r Resource) EtagProperty() *Type {
+	if r.EtagField == "" {
+		return nil
+	}
+	for _, p := range r.UserProperites() {
+		if p.Name == r.EtagField {
+			return p
+		}
+	}
+	return nil
+}
+
 func (r Resource) IsSettableProperty(t *Type) bool {
 	return slices.Contains(r.SettableProperties(), t)
 }
@@ -665,6 +810,19 @@ func (r Resource) LeafProperties() []*Type {
 	return types
 }
 
+// Returns the top-level fields the provider adds to the schema of the
+// resource without declaring them as properties, such as project.
+func (r Resource) ProviderInjectedFields() []string {
+	var fields []string
+	if r.HasProject() {
+		fields = append(fields, "project")
+	}
+	if r.HasSelfLink {
+		fields = append(fields, "self_link")
+	}
+	return fields
+}
+
 // Return the product-level async object, or the resource-specific one
 // if one exists.
 func (r Resource) GetAsync() *Async {
@@ -1061,6 +1219,11 @@ func (r Resource) TerraformName() string {
 }
 
 func (r Resource) ImportIdFormatsFromResource() []string {
+	// Singletons have no collection to list them under, so they are imported
+	// by their self link rather than by an identity within the base url.
+	if r.Singleton && len(r.ImportFormat) == 0 {
+		return ImportIdFormats([]string{r.SelfLinkUri()}, r.Identity, r.BaseUrl)
+	}
 	return ImportIdFormats(r.ImportFormat, r.Identity, r.BaseUrl)
 }
 
@@ -1284,6 +1447,53 @@ func (r Resource) IamResourceUriStringQualifiers() string {
 	return strings.Join(transformed[:], ", ")
 }
 
+// For example: "//cloudresourcemanager.googleapis.com/folders/{{folder}}"
+func (r Resource) IamDenyAttachmentPoint() string {
+	return r.IamPolicy.IamDeny.AttachmentPoint
+}
+
+// For example: "//cloudresourcemanager.googleapis.com/folders/%s"
+func (r Resource) IamDenyAttachmentPointFormat() string {
+	return regexp.MustCompile(`\{\{%?(\w+)\}\}`).ReplaceAllString(r.IamDenyAttachmentPoint(), "%s")
+}
+
+// For example: "u.folder"
+func (r Resource) IamDenyAttachmentPointStringQualifiers() string {
+	return r.iamUpdaterStringQualifiers(r.IamDenyAttachmentPoint())
+}
+
+// For example: "projects/{{project}}"
+func (r Resource) IamDenyPolicyBindingParent() string {
+	if r.IamPolicy != nil && r.IamPolicy.IamDeny != nil && r.IamPolicy.IamDeny.PolicyBindingParent != "" {
+		return r.IamPolicy.IamDeny.PolicyBindingParent
+	}
+	return r.IamResourceUri()
+}
+
+// For example: "projects/%s"
+func (r Resource) IamDenyPolicyBindingParentFormat() string {
+	return regexp.MustCompile(`\{\{%?(\w+)\}\}`).ReplaceAllString(r.IamDenyPolicyBindingParent(), "%s")
+}
+
+// For example: "u.project"
+func (r Resource) IamDenyPolicyBindingParentStringQualifiers() string {
+	return r.iamUpdaterStringQualifiers(r.IamDenyPolicyBindingParent())
+}
+
+// Returns the fields of the generated IAM updater holding the identifiers of
+// the url, which must all be IAM resource parameters.
+func (r Resource) iamUpdaterStringQualifiers(url string) string {
+	url = strings.ReplaceAll(url, "{{name}}", fmt.Sprintf("{{%s}}", r.IamParentResourceName()))
+	var transformed []string
+	for _, param := range r.ExtractIdentifiers(url) {
+		if !r.IsInIamResourceParams(param) {
+			log.Fatalf("Identifier %q in %q of resource %s is not a parameter of the IAM resource url %q", param, url, r.Name, r.IamResourceUri())
+		}
+		transformed = append(transformed, fmt.Sprintf("u.%s", google.Camelize(param, "lower")))
+	}
+	return strings.Join(transformed, ", ")
+}
+
 // For example, for the url "projects/{{project}}/schemas/{{schema}}",
 // the identifiers are "project", "schema".
 func (r Resource) ExtractIdentifiers(url string) []string {
@@ -1712,6 +1922,83 @@ func (r Resource) StateUpgradersCount() []int {
 	return nums
 }
 
+// Returns the declarative state migration upgrading from the given schema
+// version, or nil if that version uses a handwritten state_upgrader.
+func (r Resource) StateMigrationForVersion(version int) *resource.StateMigration {
+	for i, m := range r.StateMigrations {
+		if m.Version == version {
+			return &r.StateMigrations[i]
+		}
+	}
+	return nil
+}
+
+// Whether any state_upgrader version needs the handwritten file returned by
+// StateMigrationFile.
+func (r Resource) HasHandwrittenStateUpgraders() bool {
+	for _, v := range r.StateUpgradersCount() {
+		if r.StateMigrationForVersion(v) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the Go literal of the schema map of the given schema version, for
+// the state upgrader of a declarative state migration. It's derived from the
+// current schema by reverting the steps of every later migration, and only
+// holds the types needed to decode legacy flatmap state.
+func (r Resource) StateMigrationPriorSchema(version int) string {
+	fields := stateFields(google.Concat(r.AllUserProperties(), r.VirtualFields))
+	if r.HasProject() {
+		fields["project"] = &resource.StateField{Type: "schema.TypeString"}
+	}
+	if r.HasSelfLink {
+		fields["self_link"] = &resource.StateField{Type: "schema.TypeString"}
+	}
+	for v := r.SchemaVersion - 1; v >= version; v-- {
+		fields = r.StateMigrationForVersion(v).PriorFields(r.Name, fields)
+	}
+	return resource.StateFieldsGoLiteral(fields)
+}
+
+// Returns the types of the Terraform fields generated for the properties,
+// following the structure of the SchemaFields template.
+func stateFields(props []*Type) map[string]*resource.StateField {
+	fields := make(map[string]*resource.StateField)
+	for _, p := range props {
+		if p.FlattenObject {
+			for name, f := range stateFields(p.UserProperties()) {
+				fields[name] = f
+			}
+			continue
+		}
+		fields[google.Underscore(p.Name)] = stateField(p)
+	}
+	return fields
+}
+
+func stateField(p *Type) *resource.StateField {
+	f := &resource.StateField{Type: p.TFType(p.Type)}
+	if p.IsSet {
+		f.Type = "schema.TypeSet"
+	}
+	switch {
+	case p.IsA("NestedObject"):
+		f.Fields = stateFields(p.UserProperties())
+	case p.IsA("Array") && p.ItemType.IsA("NestedObject"):
+		f.Fields = stateFields(p.ItemType.UserProperties())
+	case p.IsA("Array"):
+		f.Elem = &resource.StateField{Type: p.TFType(p.ItemType.Type)}
+	case strings.HasPrefix(p.Type, "KeyValue"):
+		f.Elem = &resource.StateField{Type: "schema.TypeString"}
+	case p.IsA("Map"):
+		f.Fields = stateFields(p.ValueType.UserProperties())
+		f.Fields[p.KeyName] = &resource.StateField{Type: "schema.TypeString"}
+	}
+	return f
+}
+
 func (r Resource) CaiProductBaseUrl() string {
 	version := r.ProductMetadata.VersionObjOrClosest(r.TargetVersionName)
 	baseUrl := version.CaiBaseUrl
@@ -1756,6 +2043,95 @@ func (r Resource) CaiAssetNameTemplate(productBackendName string) string {
 	return versionRegex.ReplaceAllString(template, "/")
 }
 
+// Returns the Cai asset type of the resource
+// For example: compute.googleapis.com/Address
+func (r Resource) CaiAssetType() string {
+	return fmt.Sprintf("%s.googleapis.com/%s", r.CaiProductBackendName(r.CaiProductBaseUrl()), r.Name)
+}
+
+// Returns the top-level properties converted from Cai asset data into HCL:
+// those read from the API that can also be set in configuration. Labels and
+// annotations are returned by CaiToHclLabelsProperties instead.
+func (r Resource) CaiToHclProperties() []*Type {
+	return google.Reject(r.ReadProperties(), func(p *Type) bool {
+		return p.Output || p.UrlParamOnly || p.WriteOnly || p.IsA("KeyValueLabels") || p.IsA("KeyValueAnnotations")
+	})
+}
+
+// Returns the top-level labels and annotations properties, which are copied
+// from Cai asset data without the Terraform attribution label.
+func (r Resource) CaiToHclLabelsProperties() []*Type {
+	return google.Select(r.AllUserProperties(), func(p *Type) bool {
+		return p.IsA("KeyValueLabels") || p.IsA("KeyValueAnnotations")
+	})
+}
+
+// A ResourceRef property converted by cai2hcl to a reference to another
+// resource, such as google_pubsub_topic.x.name
+type CaiToHclReference struct {
+	// The Terraform resource type referred to, e.g. google_pubsub_topic
+	ResourceType string
+	// The attribute of the referred resource holding the property's value,
+	// from the ResourceRef's imports, e.g. name or self_link
+	Attribute string
+}
+
+// Returns the references of ResourceRef properties converted by cai2hcl,
+// keyed by the path of the property in the Terraform schema without list
+// indices, e.g. log_config.network
+func (r Resource) CaiToHclReferences() map[string]CaiToHclReference {
+	references := make(map[string]CaiToHclReference)
+	caiToHclReferences(r.CaiToHclProperties(), "", references)
+	return references
+}
+
+func caiToHclReferences(props []*Type, prefix string, references map[string]CaiToHclReference) {
+	for _, p := range props {
+		path := prefix + google.Underscore(p.Name)
+		switch {
+		case p.IsA("ResourceRef"):
+			if reference, ok := caiToHclReference(p); ok {
+				references[path] = reference
+			}
+		case p.IsA("Array") && p.ItemType.IsA("ResourceRef"):
+			if reference, ok := caiToHclReference(p.ItemType); ok {
+				references[path] = reference
+			}
+		case p.IsA("Array") && p.ItemType.IsA("NestedObject"):
+			caiToHclReferences(p.ItemType.UserProperties(), path+".", references)
+		case p.IsA("NestedObject") && p.FlattenObject:
+			caiToHclReferences(p.UserProperties(), prefix, references)
+		case p.IsA("NestedObject"):
+			caiToHclReferences(p.UserProperties(), path+".", references)
+		}
+	}
+}
+
+// Returns the reference of a ResourceRef property to the attribute it
+// imports, or false if the resource is not defined in the same product.
+func caiToHclReference(p *Type) (CaiToHclReference, bool) {
+	name := resourceRefTerraformName(p)
+	if name == "" {
+		return CaiToHclReference{}, false
+	}
+	attribute := "id"
+	if p.Imports != "" {
+		attribute = google.Underscore(p.Imports)
+	}
+	return CaiToHclReference{ResourceType: name, Attribute: attribute}, true
+}
+
+// Returns the Terraform name of the resource a ResourceRef property refers to,
+// or an empty string if the resource is not defined in the same product.
+func resourceRefTerraformName(p *Type) string {
+	for _, obj := range p.ResourceMetadata.ProductMetadata.Objects {
+		if obj.Name == p.Resource {
+			return obj.TerraformName()
+		}
+	}
+	return ""
+}
+
 // Gets the Cai API version
 func (r Resource) CaiApiVersion(productBackendName, caiProductBaseUrl string) string {
 	template := r.rawCaiAssetNameTemplate(productBackendName)
@@ -1850,7 +2226,7 @@ func (r Resource) ShouldGenerateSweepers() bool {
 	if !urlContainsOnlyAllowedKeys(r.ListUrlTemplate(), allowedKeys) {
 		return false
 	}
-	if r.ExcludeSweeper || r.CustomCode.CustomDelete != "" || r.CustomCode.PreDelete != "" || r.CustomCode.PostDelete != "" || r.ExcludeDelete {
+	if r.ExcludeSweeper || r.CustomCode.CustomDelete != "" || r.CustomCode.PreDelete != "" || r.CustomCode.PostDelete != "" || r.ExcludeDelete || r.Singleton {
 		return false
 	}
 	return true
@@ -0,0 +1,118 @@
+// Copyright 2024 Google Inc.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"log"
+
+	"golang.org/x/exp/slices"
+)
+
+const LIST_APPEND = "list_append"
+
+var BATCHING_COMBINE_STRATEGIES = []string{LIST_APPEND}
+
+// Metadata for resources whose create requests are combined through the
+// provider's transport.RequestBatcher and sent to a batch endpoint of the
+// parent, e.g. many small child objects created via `:batchCreate`.
+type Batching struct {
+	// The URL the combined request is sent to, relative to the product base
+	// URL.
+	// i.e. projects/{{project}}/locations/{{location}}/widgets:batchCreate
+	SendUrl string `yaml:"send_url"`
+
+	// Template for the key grouping batchable requests. Requests with the
+	// same key are combined into a single request. Defaults to send_url.
+	BatchKey string `yaml:"batch_key,omitempty"`
+
+	// How request bodies are combined. Only `list_append` is supported, which
+	// sends each request as an entry of the list `list_key`:
+	// {
+	//  list_key : [entry, ...]
+	// }
+	CombineStrategy string `yaml:"combine_strategy,omitempty"`
+
+	// The key of the list holding one entry per request. Defaults to
+	// "requests".
+	ListKey string `yaml:"list_key,omitempty"`
+
+	// If set, the resource body is nested under this key within its entry
+	// rather than being the entry itself.
+	// i.e. {"requests": [{"widget": {...}}]}
+	BodyKey string `yaml:"body_key,omitempty"`
+
+	// Additional fields set on each entry, as templates of the resource's
+	// fields.
+	// i.e. {"parent": "projects/{{project}}", "widgetId": "{{name}}"}
+	EntryFields map[string]string `yaml:"entry_fields,omitempty"`
+
+	// The key of the list of created resources in the batch response, or in
+	// the operation's response for async resources. Each request reads its
+	// own resource from this list.
+	// i.e. widgets
+	ResponseListKey string `yaml:"response_list_key"`
+
+	// The field identifying a created resource in `response_list_key`.
+	// Defaults to "name".
+	ResponseIdField string `yaml:"response_id_field,omitempty"`
+
+	// Template for the identifier of the request's resource. An entry is the
+	// request's resource when its `response_id_field` equals this value, or is
+	// a resource name ending in it. Defaults to "{{name}}".
+	ResponseId string `yaml:"response_id,omitempty"`
+
+	// The time to wait for a batch to be sent and return. Defaults to the
+	// resource's create timeout.
+	TimeoutMinutes int `yaml:"timeout_minutes,omitempty"`
+}
+
+func (b *Batching) SetDefault() {
+	if b.BatchKey == "" {
+		b.BatchKey = b.SendUrl
+	}
+	if b.CombineStrategy == "" {
+		b.CombineStrategy = LIST_APPEND
+	}
+	if b.ListKey == "" {
+		b.ListKey = "requests"
+	}
+	if b.ResponseIdField == "" {
+		b.ResponseIdField = "name"
+	}
+	if b.ResponseId == "" {
+		b.ResponseId = "{{name}}"
+	}
+}
+
+func (b *Batching) Validate(rName string) {
+	if b.SendUrl == "" {
+		log.Fatalf("Missing `send_url` for `batching` in resource %s", rName)
+	}
+	if b.ResponseListKey == "" {
+		log.Fatalf("Missing `response_list_key` for `batching` in resource %s", rName)
+	}
+	if !slices.Contains(BATCHING_COMBINE_STRATEGIES, b.CombineStrategy) {
+		log.Fatalf("Value on `combine_strategy` for `batching` in resource %s should be one of %#v", rName, BATCHING_COMBINE_STRATEGIES)
+	}
+}
+
+// Returns the entry field names in a stable order for generation.
+func (b Batching) EntryFieldKeys() []string {
+	var keys []string
+	for k := range b.EntryFields {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Google Inc.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"log"
+	"regexp"
+	"strings"
+)
+
+// Deny policies can only be attached to projects, folders and organizations.
+var iamDenyAttachmentPointRegex = regexp.MustCompile(`^//cloudresourcemanager\.googleapis\.com/(projects|folders|organizations)/\{\{%?\w+\}\}$`)
+
+// Information about IAM deny policies and principal access boundary (PAB)
+// policy bindings attached to this resource. Generates the
+// `_iam_deny_policy` resource, and the
+// `_iam_principal_access_boundary_binding` resource if enabled.
+// See: https://cloud.google.com/iam/docs/deny-overview
+type IamDeny struct {
+	// Full resource name of the project, folder or organization deny policies
+	// are attached to, using the same parameters as the IAM resource url.
+	// i.e. //cloudresourcemanager.googleapis.com/folders/{{folder}}
+	AttachmentPoint string `yaml:"attachment_point"`
+
+	// Whether to also generate the _iam_principal_access_boundary_binding
+	// resource. Only resources that are principal sets (projects, folders,
+	// organizations, workforce pools) can be targeted by policy bindings.
+	PrincipalAccessBoundary bool `yaml:"principal_access_boundary"`
+
+	// Parent policy bindings for this resource are created under, such as
+	// projects/{{project}}. Defaults to the IAM resource url.
+	PolicyBindingParent string `yaml:"policy_binding_parent"`
+
+	// A permission that can be denied on this resource for use in tests and
+	// docs, in the v2 format, e.g.
+	// cloudresourcemanager.googleapis.com/projects.delete
+	DeniedPermission string `yaml:"denied_permission"`
+}
+
+func (d *IamDeny) Validate(rName string) {
+	if d.DeniedPermission == "" {
+		log.Fatalf("Missing `denied_permission` for `iam_deny` in resource %s", rName)
+	}
+	if !strings.Contains(d.DeniedPermission, ".googleapis.com/") {
+		log.Fatalf("`denied_permission` for `iam_deny` in resource %s must be in the format service.googleapis.com/resource.verb", rName)
+	}
+	if d.AttachmentPoint == "" {
+		log.Fatalf("Missing `attachment_point` for `iam_deny` in resource %s", rName)
+	}
+	if !iamDenyAttachmentPointRegex.MatchString(d.AttachmentPoint) {
+		log.Fatalf("`attachment_point` for `iam_deny` in resource %s must be the full resource name of a project, folder or organization, such as //cloudresourcemanager.googleapis.com/projects/{{project}}", rName)
+	}
+	if d.PolicyBindingParent != "" && !d.PrincipalAccessBoundary {
+		log.Fatalf("`policy_binding_parent` for `iam_deny` in resource %s requires `principal_access_boundary`", rName)
+	}
+}
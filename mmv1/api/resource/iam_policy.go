@@ -114,6 +114,10 @@ type IamPolicy struct {
 	// [Optional] Check to see if zone value should be replaced with GOOGLE_ZONE in iam tests
 	// Defaults to true
 	SubstituteZoneValue bool `yaml:"substitute_zone_value"`
+
+	// [Optional] Generates IAM deny policy and principal access boundary
+	// resources for this resource alongside the IAM policy resources.
+	IamDeny *IamDeny `yaml:"iam_deny"`
 }
 
 func (p *IamPolicy) UnmarshalYAML(unmarshal func(any) error) error {
@@ -154,4 +158,8 @@ func (p *IamPolicy) Validate(rName string) {
 	if p.IamConditionsRequestType != "" && !slices.Contains(allowed, p.IamConditionsRequestType) {
 		log.Fatalf("Value on `iam_conditions_request_type` should be one of %#v in resource %s", allowed, rName)
 	}
+
+	if p.IamDeny != nil {
+		p.IamDeny.Validate(rName)
+	}
 }
@@ -0,0 +1,405 @@
+// Copyright 2024 Google Inc.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+const (
+	RENAME_FIELD      = "rename_field"
+	MOVE_INTO_BLOCK   = "move_into_block"
+	FLATTEN_LIST      = "flatten_list"
+	RENAME_ENUM_VALUE = "rename_enum_value"
+	CONVERT_UNIT      = "convert_unit"
+)
+
+var STATE_MIGRATION_STEP_TYPES = []string{RENAME_FIELD, MOVE_INTO_BLOCK, FLATTEN_LIST, RENAME_ENUM_VALUE, CONVERT_UNIT}
+
+// Declares a mechanical state upgrade from one schema version to the next.
+// The generator emits the StateUpgrader function and its unit test, so
+// no handwritten file under templates/terraform/state_migrations/ is needed.
+type StateMigration struct {
+	// The schema version upgraded from. The upgraded state is at Version + 1.
+	Version int `yaml:"version"`
+
+	// Steps applied in order to the raw JSON state.
+	Steps []StateMigrationStep `yaml:"steps"`
+}
+
+// A single step of a StateMigration. Field paths are dot-separated Terraform
+// field names, such as `settings.tier`.
+type StateMigrationStep struct {
+	// One of rename_field, move_into_block, flatten_list, rename_enum_value
+	// or convert_unit.
+	Type string `yaml:"type"`
+
+	// The field the step applies to. Used by every type but move_into_block.
+	Field string `yaml:"field,omitempty"`
+
+	// rename_field: the new name of the field, in the same parent.
+	NewName string `yaml:"new_name,omitempty"`
+
+	// move_into_block: the sibling fields moved into the block.
+	Fields []string `yaml:"fields,omitempty"`
+
+	// move_into_block: the name of the new nested block.
+	Block string `yaml:"block,omitempty"`
+
+	// rename_enum_value: the value stored in the old state.
+	OldValue string `yaml:"old_value,omitempty"`
+
+	// rename_enum_value: the value it is replaced with.
+	NewValue string `yaml:"new_value,omitempty"`
+
+	// convert_unit: the factor applied to the stored value, e.g. 1000 to
+	// convert seconds to milliseconds.
+	Multiplier float64 `yaml:"multiplier,omitempty"`
+}
+
+func (m *StateMigration) Validate(rName string, baseVersion, schemaVersion int) {
+	if m.Version < baseVersion || m.Version >= schemaVersion {
+		log.Fatalf("`version` %d of `state_migrations` in resource %s must be between `state_upgrade_base_schema_version` %d and `schema_version` %d", m.Version, rName, baseVersion, schemaVersion)
+	}
+	if len(m.Steps) == 0 {
+		log.Fatalf("Missing `steps` for `state_migrations` version %d in resource %s", m.Version, rName)
+	}
+	for _, s := range m.Steps {
+		s.Validate(rName)
+	}
+}
+
+func (s *StateMigrationStep) Validate(rName string) {
+	if !slices.Contains(STATE_MIGRATION_STEP_TYPES, s.Type) {
+		log.Fatalf("Value on `type` of `state_migrations` step in resource %s should be one of %#v", rName, STATE_MIGRATION_STEP_TYPES)
+	}
+
+	if s.Type == MOVE_INTO_BLOCK {
+		if len(s.Fields) == 0 || s.Block == "" {
+			log.Fatalf("Missing `fields` or `block` for %s step in resource %s", s.Type, rName)
+		}
+		for _, f := range s.Fields {
+			if statePathParent(f) != statePathParent(s.Fields[0]) {
+				log.Fatalf("`fields` of %s step in resource %s must share a parent block", s.Type, rName)
+			}
+		}
+		return
+	}
+
+	if s.Field == "" {
+		log.Fatalf("Missing `field` for %s step in resource %s", s.Type, rName)
+	}
+
+	switch s.Type {
+	case RENAME_FIELD:
+		if s.NewName == "" || strings.Contains(s.NewName, ".") {
+			log.Fatalf("`new_name` for %s step in resource %s must be a field name", s.Type, rName)
+		}
+	case RENAME_ENUM_VALUE:
+		if s.OldValue == "" || s.NewValue == "" {
+			log.Fatalf("Missing `old_value` or `new_value` for %s step in resource %s", s.Type, rName)
+		}
+	case CONVERT_UNIT:
+		if s.Multiplier == 0 {
+			log.Fatalf("Missing `multiplier` for %s step in resource %s", s.Type, rName)
+		}
+	}
+}
+
+// Returns the Go statement calling the tpgresource helper for this step.
+func (s StateMigrationStep) UpgradeCall() string {
+	switch s.Type {
+	case RENAME_FIELD:
+		return fmt.Sprintf("tpgresource.RenameStateField(rawState, %q, %q)", s.Field, s.NewName)
+	case MOVE_INTO_BLOCK:
+		var fields []string
+		for _, f := range s.Fields {
+			fields = append(fields, strconv.Quote(f))
+		}
+		return fmt.Sprintf("tpgresource.MoveStateFieldsIntoBlock(rawState, []string{%s}, %q)", strings.Join(fields, ", "), s.Block)
+	case FLATTEN_LIST:
+		return fmt.Sprintf("tpgresource.FlattenStateList(rawState, %q)", s.Field)
+	case RENAME_ENUM_VALUE:
+		return fmt.Sprintf("tpgresource.RenameStateEnumValue(rawState, %q, %q, %q)", s.Field, s.OldValue, s.NewValue)
+	case CONVERT_UNIT:
+		return fmt.Sprintf("tpgresource.ConvertStateUnit(rawState, %q, %s)", s.Field, strconv.FormatFloat(s.Multiplier, 'f', -1, 64))
+	}
+	return ""
+}
+
+// The type of a field of the schema a StateMigration upgrades from. Only the
+// type information is kept, which is all the prior schema is needed for:
+// decoding legacy flatmap state before it's upgraded.
+type StateField struct {
+	// The schema type, such as schema.TypeString.
+	Type string
+
+	// The type of the items of a list, set or map of primitives.
+	Elem *StateField
+
+	// The fields of a nested block.
+	Fields map[string]*StateField
+}
+
+// Returns the fields of the schema the migration upgrades from, given the
+// fields of the schema it upgrades to, by reverting its steps in reverse order.
+func (m StateMigration) PriorFields(rName string, fields map[string]*StateField) map[string]*StateField {
+	fields = copyStateFields(fields)
+	for i := len(m.Steps) - 1; i >= 0; i-- {
+		s := m.Steps[i]
+		switch s.Type {
+		case RENAME_FIELD:
+			parent := stateFieldsBlock(rName, fields, s.Field)
+			newName := statePathParent(s.Field) + s.NewName
+			parent[statePathLeaf(s.Field)] = stateFieldAt(rName, parent, newName, s.NewName)
+			delete(parent, s.NewName)
+		case MOVE_INTO_BLOCK:
+			parent := stateFieldsBlock(rName, fields, s.Fields[0])
+			block := stateFieldAt(rName, parent, statePathParent(s.Fields[0])+s.Block, s.Block)
+			for _, f := range s.Fields {
+				parent[statePathLeaf(f)] = stateFieldAt(rName, block.Fields, f, statePathLeaf(f))
+			}
+			delete(parent, s.Block)
+		case FLATTEN_LIST:
+			parent := stateFieldsBlock(rName, fields, s.Field)
+			elem := stateFieldAt(rName, parent, s.Field, statePathLeaf(s.Field))
+			parent[statePathLeaf(s.Field)] = &StateField{Type: "schema.TypeList", Elem: elem}
+		}
+	}
+	return fields
+}
+
+// Returns the Go literal of a schema map holding the fields, for the prior
+// schema of a generated state upgrader.
+func StateFieldsGoLiteral(fields map[string]*StateField) string {
+	var names []string
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("map[string]*schema.Schema{\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "%q: {\nType: %s,\nOptional: true,\n%s},\n", name, fields[name].Type, fields[name].elemGoLiteral())
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+func (f *StateField) elemGoLiteral() string {
+	switch {
+	case f.Fields != nil:
+		return fmt.Sprintf("Elem: &schema.Resource{\nSchema: %s,\n},\n", StateFieldsGoLiteral(f.Fields))
+	case f.Elem != nil && f.Elem.Fields != nil:
+		return fmt.Sprintf("Elem: &schema.Resource{\nSchema: %s,\n},\n", StateFieldsGoLiteral(f.Elem.Fields))
+	case f.Elem != nil:
+		return fmt.Sprintf("Elem: &schema.Schema{Type: %s},\n", f.Elem.Type)
+	}
+	return ""
+}
+
+func copyStateFields(fields map[string]*StateField) map[string]*StateField {
+	if fields == nil {
+		return nil
+	}
+	copied := make(map[string]*StateField, len(fields))
+	for name, f := range fields {
+		c := *f
+		if f.Elem != nil {
+			elem := *f.Elem
+			elem.Fields = copyStateFields(f.Elem.Fields)
+			c.Elem = &elem
+		}
+		c.Fields = copyStateFields(f.Fields)
+		copied[name] = &c
+	}
+	return copied
+}
+
+// Returns the fields of the block holding the field at path.
+func stateFieldsBlock(rName string, fields map[string]*StateField, path string) map[string]*StateField {
+	parts := strings.Split(path, ".")
+	for i, part := range parts[:len(parts)-1] {
+		f, ok := fields[part]
+		if !ok || f.Fields == nil {
+			log.Fatalf("`state_migrations` of resource %s refer to %s, which is not a nested block of the upgraded schema", rName, strings.Join(parts[:i+1], "."))
+		}
+		fields = f.Fields
+	}
+	return fields
+}
+
+// Returns the field named name of a block, where path is the full path of
+// the field, used for errors.
+func stateFieldAt(rName string, block map[string]*StateField, path, name string) *StateField {
+	f, ok := block[name]
+	if !ok {
+		log.Fatalf("`state_migrations` of resource %s refer to %s, which is not a field of the upgraded schema", rName, path)
+	}
+	return f
+}
+
+// A sample raw state and the state expected once a StateMigration has run,
+// both as JSON.
+type StateMigrationTestStates struct {
+	Before string
+	After  string
+}
+
+// Returns a sample raw state exercising every step of the migration. Used to
+// generate the unit test of the StateUpgrader function.
+func (m StateMigration) TestStates() StateMigrationTestStates {
+	before := map[string]any{}
+	after := map[string]any{}
+	// Fields consumed or produced by an earlier step are not seeded again, as
+	// the generated upgrader would have already moved them.
+	touched := map[string]bool{}
+
+	seed := func(path string, value any) {
+		for p := path; p != ""; p = strings.TrimSuffix(statePathParent(p), ".") {
+			if touched[p] {
+				return
+			}
+		}
+		if _, ok := lookupStatePath(after, path); ok {
+			return
+		}
+		setStatePath(before, path, value)
+		setStatePath(after, path, value)
+	}
+
+	for _, s := range m.Steps {
+		switch s.Type {
+		case RENAME_FIELD:
+			seed(s.Field, "value")
+			if v, ok := lookupStatePath(after, s.Field); ok {
+				deleteStatePath(after, s.Field)
+				setStatePath(after, statePathParent(s.Field)+s.NewName, v)
+			}
+			touched[s.Field] = true
+			touched[statePathParent(s.Field)+s.NewName] = true
+		case MOVE_INTO_BLOCK:
+			moved := map[string]any{}
+			for _, f := range s.Fields {
+				seed(f, "value-"+statePathLeaf(f))
+				if v, ok := lookupStatePath(after, f); ok {
+					moved[statePathLeaf(f)] = v
+					deleteStatePath(after, f)
+				}
+				touched[f] = true
+			}
+			if len(moved) > 0 {
+				setStatePath(after, statePathParent(s.Fields[0])+s.Block, []any{moved})
+			}
+			touched[statePathParent(s.Fields[0])+s.Block] = true
+		case FLATTEN_LIST:
+			seed(s.Field, []any{"value"})
+			if v, ok := lookupStatePath(after, s.Field); ok {
+				if l, ok := v.([]any); ok && len(l) == 1 {
+					setStatePath(after, s.Field, l[0])
+				}
+			}
+			touched[s.Field] = true
+		case RENAME_ENUM_VALUE:
+			seed(s.Field, s.OldValue)
+			if v, ok := lookupStatePath(after, s.Field); ok && v == s.OldValue {
+				setStatePath(after, s.Field, s.NewValue)
+			}
+			touched[s.Field] = true
+		case CONVERT_UNIT:
+			seed(s.Field, json.Number("3"))
+			if v, ok := lookupStatePath(after, s.Field); ok {
+				if n, ok := v.(json.Number); ok {
+					f, _ := n.Float64()
+					setStatePath(after, s.Field, json.Number(strconv.FormatFloat(f*s.Multiplier, 'f', -1, 64)))
+				}
+			}
+			touched[s.Field] = true
+		}
+	}
+
+	beforeJson, err := json.Marshal(before)
+	if err != nil {
+		log.Fatalf("Cannot marshal state migration test state: %v", err)
+	}
+	afterJson, err := json.Marshal(after)
+	if err != nil {
+		log.Fatalf("Cannot marshal state migration test state: %v", err)
+	}
+	return StateMigrationTestStates{Before: string(beforeJson), After: string(afterJson)}
+}
+
+// Returns the path of the block holding the field, with a trailing dot, or an
+// empty string for top-level fields.
+func statePathParent(path string) string {
+	if i := strings.LastIndex(path, "."); i >= 0 {
+		return path[:i+1]
+	}
+	return ""
+}
+
+func statePathLeaf(path string) string {
+	return strings.TrimPrefix(path, statePathParent(path))
+}
+
+// Nested blocks are stored in JSON state as lists of one object; sample
+// states only ever hold a single element per block.
+func statePathBlock(state map[string]any, path string, create bool) map[string]any {
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		l, ok := state[part].([]any)
+		if !ok || len(l) == 0 {
+			if !create {
+				return nil
+			}
+			l = []any{map[string]any{}}
+			state[part] = l
+		}
+		next, ok := l[0].(map[string]any)
+		if !ok {
+			return nil
+		}
+		state = next
+	}
+	return state
+}
+
+func lookupStatePath(state map[string]any, path string) (any, bool) {
+	block := statePathBlock(state, path, false)
+	if block == nil {
+		return nil, false
+	}
+	v, ok := block[statePathLeaf(path)]
+	return v, ok
+}
+
+func setStatePath(state map[string]any, path string, value any) {
+	if block := statePathBlock(state, path, true); block != nil {
+		block[statePathLeaf(path)] = value
+	}
+}
+
+func deleteStatePath(state map[string]any, path string) {
+	if block := statePathBlock(state, path, false); block != nil {
+		delete(block, statePathLeaf(path))
+	}
+}
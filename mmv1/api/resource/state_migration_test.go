@@ -0,0 +1,156 @@
+package resource
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStateMigrationTestStates(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		obj         StateMigration
+		expected    StateMigrationTestStates
+	}{
+		{
+			description: "rename then rename enum value",
+			obj: StateMigration{
+				Steps: []StateMigrationStep{
+					{Type: RENAME_FIELD, Field: "old", NewName: "tier"},
+					{Type: RENAME_ENUM_VALUE, Field: "tier", OldValue: "value", NewValue: "VALUE"},
+				},
+			},
+			expected: StateMigrationTestStates{
+				Before: `{"old":"value"}`,
+				After:  `{"tier":"VALUE"}`,
+			},
+		},
+		{
+			description: "nested fields",
+			obj: StateMigration{
+				Steps: []StateMigrationStep{
+					{Type: MOVE_INTO_BLOCK, Fields: []string{"spec.a", "spec.b"}, Block: "config"},
+					{Type: CONVERT_UNIT, Field: "spec.timeout", Multiplier: 1000},
+					{Type: FLATTEN_LIST, Field: "tags"},
+				},
+			},
+			expected: StateMigrationTestStates{
+				Before: `{"spec":[{"a":"value-a","b":"value-b","timeout":3}],"tags":["value"]}`,
+				After:  `{"spec":[{"config":[{"a":"value-a","b":"value-b"}],"timeout":3000}],"tags":"value"}`,
+			},
+		},
+		{
+			description: "fields inside a block created by an earlier step are not seeded",
+			obj: StateMigration{
+				Steps: []StateMigrationStep{
+					{Type: MOVE_INTO_BLOCK, Fields: []string{"a"}, Block: "config"},
+					{Type: CONVERT_UNIT, Field: "config.timeout", Multiplier: 1000},
+				},
+			},
+			expected: StateMigrationTestStates{
+				Before: `{"a":"value-a"}`,
+				After:  `{"config":[{"a":"value-a"}]}`,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tc.obj.TestStates(), tc.expected; got != want {
+				t.Errorf("expected %v to be %v", got, want)
+			}
+		})
+	}
+}
+
+func TestStateMigrationPriorFields(t *testing.T) {
+	t.Parallel()
+
+	str := func() *StateField { return &StateField{Type: "schema.TypeString"} }
+	cases := []struct {
+		description string
+		obj         StateMigration
+		fields      map[string]*StateField
+		expected    map[string]*StateField
+	}{
+		{
+			description: "rename",
+			obj: StateMigration{
+				Steps: []StateMigrationStep{
+					{Type: RENAME_FIELD, Field: "spec.old", NewName: "tier"},
+					{Type: RENAME_ENUM_VALUE, Field: "spec.tier", OldValue: "value", NewValue: "VALUE"},
+				},
+			},
+			fields: map[string]*StateField{
+				"spec": {Type: "schema.TypeList", Fields: map[string]*StateField{"tier": str()}},
+			},
+			expected: map[string]*StateField{
+				"spec": {Type: "schema.TypeList", Fields: map[string]*StateField{"old": str()}},
+			},
+		},
+		{
+			description: "move into block and flatten list",
+			obj: StateMigration{
+				Steps: []StateMigrationStep{
+					{Type: MOVE_INTO_BLOCK, Fields: []string{"a", "b"}, Block: "config"},
+					{Type: FLATTEN_LIST, Field: "tags"},
+					{Type: CONVERT_UNIT, Field: "config.a", Multiplier: 1000},
+				},
+			},
+			fields: map[string]*StateField{
+				"config": {Type: "schema.TypeList", Fields: map[string]*StateField{
+					"a": {Type: "schema.TypeInt"},
+					"b": str(),
+				}},
+				"tags": str(),
+				"name": str(),
+			},
+			expected: map[string]*StateField{
+				"a":    {Type: "schema.TypeInt"},
+				"b":    str(),
+				"tags": {Type: "schema.TypeList", Elem: str()},
+				"name": str(),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.obj.PriorFields("resource", tc.fields)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %s to be %s", StateFieldsGoLiteral(got), StateFieldsGoLiteral(tc.expected))
+			}
+		})
+	}
+}
+
+func TestStateFieldsGoLiteral(t *testing.T) {
+	t.Parallel()
+
+	fields := map[string]*StateField{
+		"name": {Type: "schema.TypeString"},
+		"tags": {Type: "schema.TypeSet", Elem: &StateField{Type: "schema.TypeString"}},
+		"spec": {Type: "schema.TypeList", Fields: map[string]*StateField{
+			"size": {Type: "schema.TypeInt"},
+		}},
+	}
+	expected := "map[string]*schema.Schema{\n" +
+		"\"name\": {\nType: schema.TypeString,\nOptional: true,\n},\n" +
+		"\"spec\": {\nType: schema.TypeList,\nOptional: true,\nElem: &schema.Resource{\nSchema: map[string]*schema.Schema{\n" +
+		"\"size\": {\nType: schema.TypeInt,\nOptional: true,\n},\n" +
+		"},\n},\n},\n" +
+		"\"tags\": {\nType: schema.TypeSet,\nOptional: true,\nElem: &schema.Schema{Type: schema.TypeString},\n},\n" +
+		"}"
+	if got := StateFieldsGoLiteral(fields); got != expected {
+		t.Errorf("expected %q to be %q", got, expected)
+	}
+}
@@ -13,9 +13,39 @@
 
 package resource
 
+import "regexp"
+
 // Support for schema ValidateFunc functionality.
 type Validation struct {
 	// Ensures the value matches this regex
 	Regex    string
 	Function string
 }
+
+// ValidationBounds holds the bounds enforced by a validation function, as written in
+// the function call. Bounds apply to the length of strings and to the value of numbers.
+type ValidationBounds struct {
+	Min string
+	Max string
+}
+
+var (
+	betweenValidationRegex = regexp.MustCompile(`^validation\.(?:IntBetween|FloatBetween|StringLenBetween)\(\s*([^,\s]+)\s*,\s*([^)\s]+)\s*\)$`)
+	atLeastValidationRegex = regexp.MustCompile(`^validation\.(?:IntAtLeast|FloatAtLeast)\(\s*([^)\s]+)\s*\)$`)
+	atMostValidationRegex  = regexp.MustCompile(`^validation\.(?:IntAtMost|FloatAtMost)\(\s*([^)\s]+)\s*\)$`)
+)
+
+// Bounds returns the bounds enforced by the validation function, if it's one of the
+// bounded validation functions of the SDK's validation package.
+func (v Validation) Bounds() ValidationBounds {
+	if m := betweenValidationRegex.FindStringSubmatch(v.Function); m != nil {
+		return ValidationBounds{Min: m[1], Max: m[2]}
+	}
+	if m := atLeastValidationRegex.FindStringSubmatch(v.Function); m != nil {
+		return ValidationBounds{Min: m[1]}
+	}
+	if m := atMostValidationRegex.FindStringSubmatch(v.Function); m != nil {
+		return ValidationBounds{Max: m[1]}
+	}
+	return ValidationBounds{}
+}
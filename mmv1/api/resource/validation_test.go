@@ -0,0 +1,64 @@
+package resource
+
+import (
+	"testing"
+)
+
+func TestValidationBounds(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		obj         Validation
+		expected    ValidationBounds
+	}{
+		{
+			description: "int between",
+			obj:         Validation{Function: "validation.IntBetween(0,60)"},
+			expected:    ValidationBounds{Min: "0", Max: "60"},
+		},
+		{
+			description: "float between with spaces",
+			obj:         Validation{Function: "validation.FloatBetween(0, 100)"},
+			expected:    ValidationBounds{Min: "0", Max: "100"},
+		},
+		{
+			description: "string length between",
+			obj:         Validation{Function: "validation.StringLenBetween(0, 1024)"},
+			expected:    ValidationBounds{Min: "0", Max: "1024"},
+		},
+		{
+			description: "at least",
+			obj:         Validation{Function: "validation.IntAtLeast(1)"},
+			expected:    ValidationBounds{Min: "1"},
+		},
+		{
+			description: "at most",
+			obj:         Validation{Function: "validation.IntAtMost(10)"},
+			expected:    ValidationBounds{Max: "10"},
+		},
+		{
+			description: "unbounded function",
+			obj:         Validation{Function: "validation.StringIsJSON"},
+			expected:    ValidationBounds{},
+		},
+		{
+			description: "regex",
+			obj:         Validation{Regex: "^[a-z]+$"},
+			expected:    ValidationBounds{},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.obj.Bounds()
+			if got != tc.expected {
+				t.Errorf("expected %v to be %v", got, tc.expected)
+			}
+		})
+	}
+}
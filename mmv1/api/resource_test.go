@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/GoogleCloudPlatform/magic-modules/mmv1/api/product"
+	"github.com/GoogleCloudPlatform/magic-modules/mmv1/api/resource"
 )
 
 func TestResourceMinVersionObj(t *testing.T) {
@@ -202,6 +203,52 @@ func TestResourceServiceVersion(t *testing.T) {
 	}
 }
 
+func TestResourceProviderInjectedFields(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		obj         Resource
+		expected    []string
+	}{
+		{
+			description: "no project",
+			obj: Resource{
+				BaseUrl: "organizations/{{organization}}/widgets",
+			},
+			expected: nil,
+		},
+		{
+			description: "project in BaseUrl",
+			obj: Resource{
+				BaseUrl: "projects/{{project}}/widgets",
+			},
+			expected: []string{"project"},
+		},
+		{
+			description: "project in CreateUrl with self link",
+			obj: Resource{
+				BaseUrl:     "widgets",
+				CreateUrl:   "projects/{{project}}/widgets",
+				HasSelfLink: true,
+			},
+			expected: []string{"project", "self_link"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tc.obj.ProviderInjectedFields(), tc.expected; !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %q to be %q", got, want)
+			}
+		})
+	}
+}
+
 func TestLeafProperties(t *testing.T) {
 	t.Parallel()
 
@@ -319,6 +366,55 @@ func TestLeafProperties(t *testing.T) {
 	}
 }
 
+func TestResourceImportIdFormatsFromResource(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		obj         Resource
+		expected    []string
+	}{
+		{
+			description: "collection resource",
+			obj: Resource{
+				BaseUrl: "projects/{{project}}/widgets",
+			},
+			expected: []string{"projects/{{project}}/widgets/{{name}}", "{{project}}/{{name}}", "{{name}}"},
+		},
+		{
+			description: "singleton resource",
+			obj: Resource{
+				BaseUrl:   "projects/{{project}}/settings",
+				SelfLink:  "projects/{{project}}/settings",
+				Singleton: true,
+			},
+			expected: []string{"projects/{{project}}/settings", "{{project}}"},
+		},
+		{
+			description: "singleton resource with import_format",
+			obj: Resource{
+				BaseUrl:      "projects/{{project}}/settings",
+				SelfLink:     "projects/{{project}}/settings",
+				ImportFormat: []string{"projects/{{project}}/settings/default"},
+				Singleton:    true,
+			},
+			expected: []string{"projects/{{project}}/settings/default", "{{project}}"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tc.obj.ImportIdFormatsFromResource(), tc.expected; !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %v to be %v", got, want)
+			}
+		})
+	}
+}
+
 // TestMagicianLocation verifies that the current package is being executed from within
 // the RELATIVE_MAGICIAN_LOCATION ("mmv1/") directory structure. This ensures that references
 // to files relative to this location will remain valid even if the repository structure
@@ -357,3 +453,35 @@ func TestMagicianLocation(t *testing.T) {
 		t.Errorf("Current package is not under %s. Path from magician dir to current dir: %s", RELATIVE_MAGICIAN_LOCATION, relPath)
 	}
 }
+
+func TestResourceIamDeny(t *testing.T) {
+	t.Parallel()
+
+	r := Resource{
+		Name: "Folder",
+		IamPolicy: &resource.IamPolicy{
+			BaseUrl:                 "folders/{{folder}}",
+			ParentResourceAttribute: "folder",
+			IamDeny: &resource.IamDeny{
+				AttachmentPoint:         "//cloudresourcemanager.googleapis.com/folders/{{folder}}",
+				PrincipalAccessBoundary: true,
+			},
+		},
+	}
+
+	cases := []struct {
+		description string
+		got         string
+		expected    string
+	}{
+		{"attachment point format", r.IamDenyAttachmentPointFormat(), "//cloudresourcemanager.googleapis.com/folders/%s"},
+		{"attachment point qualifiers", r.IamDenyAttachmentPointStringQualifiers(), "u.folder"},
+		{"policy binding parent format", r.IamDenyPolicyBindingParentFormat(), "folders/%s"},
+		{"policy binding parent qualifiers", r.IamDenyPolicyBindingParentStringQualifiers(), "u.folder"},
+	}
+	for _, tc := range cases {
+		if tc.got != tc.expected {
+			t.Errorf("%s: expected %q, got %q", tc.description, tc.expected, tc.got)
+		}
+	}
+}
@@ -1148,6 +1148,33 @@ func (t *Type) ProviderOnly() bool {
 	return parent != nil && parent.ProviderOnly()
 }
 
+// Returns the enum values that the field (or each of its items, for arrays) is
+// validated against, for resource metadata
+func (t Type) MetadataEnumValues() []string {
+	if t.Output {
+		return nil
+	}
+	if t.IsA("Enum") {
+		return t.EnumValues
+	}
+	if t.IsA("Array") && t.ItemType != nil && t.ItemType.IsA("Enum") {
+		return t.ItemType.EnumValues
+	}
+	return nil
+}
+
+// Returns the validation of the field (or of each of its items, for arrays), for
+// resource metadata
+func (t Type) MetadataValidation() resource.Validation {
+	if t.Output {
+		return resource.Validation{}
+	}
+	if t.IsA("Array") {
+		return t.ItemValidation
+	}
+	return t.Validation
+}
+
 // Returns an updated path for a given Terraform field path (e.g.
 // 'a_field', 'parent_field.0.child_name'). Returns nil if the property
 // is not included in the resource's properties and removes keys that have
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/GoogleCloudPlatform/magic-modules/mmv1/api/product"
+	"github.com/GoogleCloudPlatform/magic-modules/mmv1/api/resource"
 )
 
 func TestTypeMinVersionObj(t *testing.T) {
@@ -425,3 +426,86 @@ func TestProviderOnly(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataEnumValues(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		obj         Type
+		expected    []string
+	}{
+		{
+			description: "enum",
+			obj:         Type{Type: "Enum", EnumValues: []string{"A", "B"}},
+			expected:    []string{"A", "B"},
+		},
+		{
+			description: "output enum",
+			obj:         Type{Type: "Enum", EnumValues: []string{"A", "B"}, Output: true},
+			expected:    nil,
+		},
+		{
+			description: "array of enums",
+			obj:         Type{Type: "Array", ItemType: &Type{Type: "Enum", EnumValues: []string{"A"}}},
+			expected:    []string{"A"},
+		},
+		{
+			description: "string",
+			obj:         Type{Type: "String"},
+			expected:    nil,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.obj.MetadataEnumValues()
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %q to be %q", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestMetadataValidation(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		obj         Type
+		expected    resource.Validation
+	}{
+		{
+			description: "string with regex",
+			obj:         Type{Type: "String", Validation: resource.Validation{Regex: "^[a-z]+$"}},
+			expected:    resource.Validation{Regex: "^[a-z]+$"},
+		},
+		{
+			description: "output string with regex",
+			obj:         Type{Type: "String", Validation: resource.Validation{Regex: "^[a-z]+$"}, Output: true},
+			expected:    resource.Validation{},
+		},
+		{
+			description: "array with item validation",
+			obj:         Type{Type: "Array", ItemType: &Type{Type: "String"}, ItemValidation: resource.Validation{Function: "validation.StringLenBetween(0, 64)"}},
+			expected:    resource.Validation{Function: "validation.StringLenBetween(0, 64)"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.obj.MetadataValidation()
+			if got != tc.expected {
+				t.Errorf("expected %v to be %v", got, tc.expected)
+			}
+		})
+	}
+}
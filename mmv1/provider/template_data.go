@@ -81,6 +81,8 @@ func (td *TemplateData) GenerateResourceFile(filePath string, resource api.Resou
 		"templates/terraform/expand_property_method.go.tmpl",
 		"templates/terraform/update_mask.go.tmpl",
 		"templates/terraform/nested_query.go.tmpl",
+		"templates/terraform/batching.go.tmpl",
+		"templates/terraform/etag.go.tmpl",
 		"templates/terraform/unordered_list_customize_diff.go.tmpl",
 	}
 	td.GenerateFile(filePath, templatePath, resource, true, templates...)
@@ -141,6 +143,14 @@ func (td *TemplateData) GenerateTestFile(filePath string, resource api.Resource)
 	td.GenerateFile(filePath, templatePath, tmplInput, true, templates...)
 }
 
+func (td *TemplateData) GenerateStateMigrationTestFile(filePath string, resource api.Resource) {
+	templatePath := "templates/terraform/state_migration_test.go.tmpl"
+	templates := []string{
+		templatePath,
+	}
+	td.GenerateFile(filePath, templatePath, resource, true, templates...)
+}
+
 func (td *TemplateData) GenerateIamPolicyFile(filePath string, resource api.Resource) {
 	templatePath := "templates/terraform/iam_policy.go.tmpl"
 	templates := []string{
@@ -192,6 +202,34 @@ func (td *TemplateData) GenerateTGCResourceFile(filePath string, resource api.Re
 	td.GenerateFile(filePath, templatePath, resource, true, templates...)
 }
 
+func (td *TemplateData) GenerateCaiToHclResourceFile(filePath string, resource api.Resource) {
+	templatePath := "templates/cai2hcl/resource_converter.go.tmpl"
+	templates := []string{
+		templatePath,
+		"templates/terraform/custom_flatten/bigquery_table_ref.go.tmpl",
+		"templates/terraform/flatten_property_method.go.tmpl",
+	}
+	td.GenerateFile(filePath, templatePath, resource, true, templates...)
+}
+
+func (td *TemplateData) GenerateTGCNextCaiToHclResourceFile(filePath string, resource api.Resource) {
+	templatePath := "templates/tgc_next/cai2hcl/resource_converter.go.tmpl"
+	templates := []string{
+		templatePath,
+		"templates/terraform/custom_flatten/bigquery_table_ref.go.tmpl",
+		"templates/terraform/flatten_property_method.go.tmpl",
+	}
+	td.GenerateFile(filePath, templatePath, resource, true, templates...)
+}
+
+func (td *TemplateData) GenerateTGCNextRoundTripTestFile(filePath string, resource api.Resource) {
+	templatePath := "templates/tgc_next/test/roundtrip_test.go.tmpl"
+	templates := []string{
+		templatePath,
+	}
+	td.GenerateFile(filePath, templatePath, resource, true, templates...)
+}
+
 func (td *TemplateData) GenerateTGCIamResourceFile(filePath string, resource api.Resource) {
 	templatePath := "templates/tgc/resource_converter_iam.go.tmpl"
 	templates := []string{
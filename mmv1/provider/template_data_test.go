@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/magic-modules/mmv1/api"
+)
+
+// chdirMmv1 changes to the mmv1 directory for the duration of the test, as
+// templates are read relative to it.
+func chdirMmv1(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(".."); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.Chdir(wd)
+	})
+}
+
+func TestGenerateResourceFileBatching(t *testing.T) {
+	chdirMmv1(t)
+
+	product := &api.Product{}
+	api.Compile("provider/testdata/batching/product.yaml", product, "")
+	resource := &api.Resource{}
+	api.Compile("provider/testdata/batching/Widget.yaml", resource, "")
+	resource.TargetVersionName = GA_VERSION
+	resource.Properties = resource.AddLabelsRelatedFields(resource.PropertiesWithExcluded(), nil)
+	resource.SetDefault(product)
+	resource.Validate()
+	product.Objects = []*api.Resource{resource}
+
+	filePath := filepath.Join(t.TempDir(), "resource_widgets_widget.go")
+	NewTemplateData(t.TempDir(), GA_VERSION).GenerateResourceFile(filePath, *resource)
+
+	f, err := parser.ParseFile(token.NewFileSet(), filePath, nil, 0)
+	if err != nil {
+		t.Fatalf("Generated resource does not parse: %s", err)
+	}
+	funcs := map[string]bool{}
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			funcs[fn.Name.Name] = true
+		}
+	}
+	if !funcs["resourceWidgetsWidgetBatchCreate"] {
+		t.Errorf("Expected generated resource to declare resourceWidgetsWidgetBatchCreate, got %v", funcs)
+	}
+
+	b, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := string(b)
+	for _, want := range []string{
+		`"{{WidgetsBasePath}}{{parent}}/widgets:batchCreate"`,
+		`resourceWidgetsWidgetBatchCreate(d, config, url, obj, "", billingProject, userAgent, headers)`,
+		`"widget": obj`,
+		`entry["widgetId"] = widgetIdEntry`,
+		`CombineF: transport_tpg.CombineListAppendBatches("requests")`,
+		`WidgetsOperationWaitTimeWithResponse(`,
+		`transport_tpg.BatchResponseEntry(res, "widgets", "name", responseId)`,
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("Expected generated resource to contain %s", want)
+		}
+	}
+	// Batched creates read the created resource from the batch response
+	// rather than waiting on an operation of their own.
+	if strings.Contains(src, `"Creating Widget", userAgent`) {
+		t.Errorf("Expected generated resource not to wait on a create operation per resource")
+	}
+}
@@ -104,6 +104,7 @@ func (t *Terraform) GenerateObject(object api.Resource, outputFolder, productPat
 		if generateCode {
 			// log.Printf("Generating %s tests", object.Name)
 			t.GenerateResourceTests(object, *templateData, outputFolder)
+			t.GenerateStateMigrationTests(object, *templateData, outputFolder)
 			t.GenerateResourceSweeper(object, *templateData, outputFolder)
 			// log.Printf("Generating %s metadata", object.Name)
 			t.GenerateResourceMetadata(object, *templateData, outputFolder)
@@ -172,6 +173,20 @@ func (t *Terraform) GenerateResourceTests(object api.Resource, templateData Temp
 	templateData.GenerateTestFile(targetFilePath, object)
 }
 
+func (t *Terraform) GenerateStateMigrationTests(object api.Resource, templateData TemplateData, outputFolder string) {
+	if object.SchemaVersion == 0 || len(object.StateMigrations) == 0 {
+		return
+	}
+
+	productName := t.Product.ApiName
+	targetFolder := path.Join(outputFolder, t.FolderName(), "services", productName)
+	if err := os.MkdirAll(targetFolder, os.ModePerm); err != nil {
+		log.Println(fmt.Errorf("error creating parent directory %v: %v", targetFolder, err))
+	}
+	targetFilePath := path.Join(targetFolder, fmt.Sprintf("resource_%s_state_migration_generated_test.go", t.ResourceGoFilename(object)))
+	templateData.GenerateStateMigrationTestFile(targetFilePath, object)
+}
+
 func (t *Terraform) GenerateResourceSweeper(object api.Resource, templateData TemplateData, outputFolder string) {
 	if !object.ShouldGenerateSweepers() {
 		return
@@ -690,6 +705,8 @@ func (t Terraform) GetMmv1ServicesInVersion(products []*api.Product) []string {
 // #    terraform_name:
 // #    resource_name:
 // #    iam_class_name:
+// #    iam_deny:
+// #    iam_principal_access_boundary:
 // # }
 // # The variable resources_for_version is used to generate resources in file
 // # mmv1/third_party/terraform/provider/provider_mmv1_resources.go.erb
@@ -708,20 +725,34 @@ func (t *Terraform) generateResourcesForVersion(products []*api.Product) {
 				resourceName = fmt.Sprintf("%s.Resource%s", service, object.ResourceName())
 			}
 
-			var iamClassName string
+			var iamClassName, iamDeny, iamPrincipalAccessBoundary string
 			iamPolicy := object.IamPolicy
 			if iamPolicy != nil && !iamPolicy.Exclude {
 				t.IAMResourceCount += 3
+				if iamPolicy.IamDeny != nil {
+					t.IAMResourceCount++
+					if iamPolicy.IamDeny.PrincipalAccessBoundary {
+						t.IAMResourceCount++
+					}
+				}
 
 				if slices.Index(product.ORDER, iamPolicy.MinVersion) <= slices.Index(product.ORDER, t.TargetVersionName) {
 					iamClassName = fmt.Sprintf("%s.%s", service, object.ResourceName())
+					if iamPolicy.IamDeny != nil {
+						iamDeny = "true"
+						if iamPolicy.IamDeny.PrincipalAccessBoundary {
+							iamPrincipalAccessBoundary = "true"
+						}
+					}
 				}
 			}
 
 			t.ResourcesForVersion = append(t.ResourcesForVersion, map[string]string{
-				"TerraformName": object.TerraformName(),
-				"ResourceName":  resourceName,
-				"IamClassName":  iamClassName,
+				"TerraformName":              object.TerraformName(),
+				"ResourceName":               resourceName,
+				"IamClassName":               iamClassName,
+				"IamDeny":                    iamDeny,
+				"IamPrincipalAccessBoundary": iamPrincipalAccessBoundary,
 			})
 		}
 	}
@@ -410,6 +410,8 @@ func (tgc TerraformGoogleConversion) CopyCommonFiles(outputFolder string, genera
 		"converters/google/resources/services/storage/iam_storage_bucket.go":                    "third_party/tgc/services/storage/iam_storage_bucket.go",
 		"ancestrymanager/ancestrymanager.go":                                                    "third_party/tgc/ancestrymanager/ancestrymanager.go",
 		"ancestrymanager/ancestrymanager_test.go":                                               "third_party/tgc/ancestrymanager/ancestrymanager_test.go",
+		"ancestrymanager/caiexport.go":                                                          "third_party/tgc/ancestrymanager/caiexport.go",
+		"ancestrymanager/caiexport_test.go":                                                     "third_party/tgc/ancestrymanager/caiexport_test.go",
 		"ancestrymanager/ancestryutil.go":                                                       "third_party/tgc/ancestrymanager/ancestryutil.go",
 		"ancestrymanager/ancestryutil_test.go":                                                  "third_party/tgc/ancestrymanager/ancestryutil_test.go",
 		"converters/google/convert.go":                                                          "third_party/tgc/convert.go",
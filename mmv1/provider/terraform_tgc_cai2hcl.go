@@ -14,18 +14,31 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"path"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"golang.org/x/exp/slices"
+
 	"github.com/GoogleCloudPlatform/magic-modules/mmv1/api"
 	"github.com/GoogleCloudPlatform/magic-modules/mmv1/api/product"
+	"github.com/GoogleCloudPlatform/magic-modules/mmv1/google"
 	"github.com/otiai10/copy"
 )
 
 // Code generator for a library converting GCP CAI objects to Terraform state.
 type CaiToTerraformConversion struct {
+	// Converters registered in the converter map, generated or handwritten
+	CaiToHclConverters []map[string]string
+
+	// Service packages containing the registered converters
+	CaiToHclServices []string
+
 	TargetVersionName string
 
 	Version product.Version
@@ -53,9 +66,56 @@ func NewCaiToTerraformConversion(product *api.Product, versionName string, start
 }
 
 func (cai2hcl CaiToTerraformConversion) Generate(outputFolder, productPath, resourceToGenerate string, generateCode, generateDocs bool) {
+	if !generateCode {
+		return
+	}
+
+	for _, object := range cai2hcl.Product.Objects {
+		object.ExcludeIfNotInVersion(&cai2hcl.Version)
+
+		if resourceToGenerate != "" && object.Name != resourceToGenerate {
+			log.Printf("Excluding %s per user request", object.Name)
+			continue
+		}
+
+		cai2hcl.GenerateObject(*object, outputFolder)
+	}
+}
+
+func (cai2hcl CaiToTerraformConversion) GenerateObject(object api.Resource, outputFolder string) {
+	if !hasCaiToHclConverter(object, &cai2hcl.Version) {
+		return
+	}
+
+	productName := cai2hcl.Product.ApiName
+	fileName := fmt.Sprintf("%s_%s.go", productName, google.Underscore(object.Name))
+	if isHandwrittenCaiToHclConverter(path.Join("third_party/cai2hcl/services", productName, fileName)) {
+		log.Printf("Skipping %s, which has a handwritten cai2hcl converter", object.Name)
+		return
+	}
+
+	targetFolder := path.Join(outputFolder, "services", productName)
+	if err := os.MkdirAll(targetFolder, os.ModePerm); err != nil {
+		log.Println(fmt.Errorf("error creating parent directory %v: %v", targetFolder, err))
+	}
+
+	templateData := NewTemplateData(outputFolder, cai2hcl.TargetVersionName)
+	templateData.GenerateCaiToHclResourceFile(path.Join(targetFolder, fileName), object)
 }
 
 func (cai2hcl CaiToTerraformConversion) CompileCommonFiles(outputFolder string, products []*api.Product, overridePath string) {
+	log.Printf("Compiling common files for cai2hcl.")
+
+	cai2hcl.CaiToHclConverters, cai2hcl.CaiToHclServices = caiToHclConverters(products, cai2hcl.TargetVersionName, "compute", "resourcemanager")
+
+	files := map[string]string{
+		"converter_map.go": "templates/cai2hcl/converter_map.go.tmpl",
+	}
+	templateData := NewTemplateData(outputFolder, cai2hcl.TargetVersionName)
+	for target, source := range files {
+		targetFile := filepath.Join(outputFolder, target)
+		templateData.GenerateFile(targetFile, source, cai2hcl, true, source)
+	}
 }
 
 func (cai2hcl CaiToTerraformConversion) CopyCommonFiles(outputFolder string, generateCode, generateDocs bool) {
@@ -72,3 +132,68 @@ func (cai2hcl CaiToTerraformConversion) CopyCommonFiles(outputFolder string, gen
 		log.Println(fmt.Errorf("error copying directory %v: %v", outputFolder, err))
 	}
 }
+
+// Whether cai2hcl converts the Cai assets of the resource to HCL, using a
+// generated or handwritten converter.
+func hasCaiToHclConverter(object api.Resource, version *product.Version) bool {
+	return !object.IsExcluded() && !object.ExcludeTgc && !object.NotInVersion(version)
+}
+
+// Whether a handwritten converter exists for the resource, in which case no
+// converter is generated for it.
+func isHandwrittenCaiToHclConverter(source string) bool {
+	_, err := os.Stat(source)
+	return !errors.Is(err, os.ErrNotExist)
+}
+
+// Generates the list of converters registered in cai2hcl, along with the
+// service packages containing them. Resources with a Cai asset type that is
+// already converted by another resource are skipped.
+func caiToHclConverters(products []*api.Product, versionName string, handwrittenServices ...string) ([]map[string]string, []string) {
+	var converters []map[string]string
+	services := slices.Clone(handwrittenServices)
+	assetTypes := make(map[string]bool)
+	for _, productDefinition := range products {
+		service := strings.ToLower(productDefinition.Name)
+		for _, object := range productDefinition.Objects {
+			if !hasCaiToHclConverter(*object, productDefinition.VersionObjOrClosest(versionName)) {
+				continue
+			}
+
+			assetType := object.CaiAssetType()
+			if assetTypes[assetType] {
+				log.Printf("Skipping cai2hcl converter for %s, as %s assets are already converted", object.TerraformName(), assetType)
+				continue
+			}
+			assetTypes[assetType] = true
+
+			converters = append(converters, map[string]string{
+				"TerraformName": object.TerraformName(),
+				"Service":       service,
+				"ResourceName":  object.ResourceName(),
+			})
+			services = append(services, service)
+		}
+	}
+
+	slices.Sort(services)
+	return converters, slices.Compact(services)
+}
+
+// Generates the attributes referring to other resources for each resource
+// with a generated or handwritten cai2hcl converter, keyed by Terraform
+// resource type. Resources without references are omitted.
+func caiToHclReferences(products []*api.Product, versionName string) map[string]map[string]api.CaiToHclReference {
+	references := make(map[string]map[string]api.CaiToHclReference)
+	for _, productDefinition := range products {
+		for _, object := range productDefinition.Objects {
+			if !hasCaiToHclConverter(*object, productDefinition.VersionObjOrClosest(versionName)) {
+				continue
+			}
+			if objectReferences := object.CaiToHclReferences(); len(objectReferences) > 0 {
+				references[object.TerraformName()] = objectReferences
+			}
+		}
+	}
+	return references
+}
@@ -21,17 +21,28 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
 	"time"
 
 	"github.com/GoogleCloudPlatform/magic-modules/mmv1/api"
 	"github.com/GoogleCloudPlatform/magic-modules/mmv1/api/product"
+	"github.com/GoogleCloudPlatform/magic-modules/mmv1/google"
 	"github.com/otiai10/copy"
 )
 
 // This proivder is for both tfplan2cai and cai2hcl conversions,
 // and copying other files, such as transport.go
 type TerraformGoogleConversionNext struct {
+	// Converters registered in the cai2hcl converter map, generated or handwritten
+	CaiToHclConverters []map[string]string
+
+	// Service packages containing the registered cai2hcl converters
+	CaiToHclServices []string
+
+	// Attributes referring to other resources, keyed by Terraform resource type
+	CaiToHclReferences map[string]map[string]api.CaiToHclReference
+
 	TargetVersionName string
 
 	Version product.Version
@@ -67,6 +78,65 @@ func (tgc TerraformGoogleConversionNext) GenerateTfToCaiObjects(outputFolder, re
 }
 
 func (tgc TerraformGoogleConversionNext) GenerateCaiToHclObjects(outputFolder, resourceToGenerate string, generateCode, generateDocs bool) {
+	if !generateCode {
+		return
+	}
+
+	for _, object := range tgc.Product.Objects {
+		object.ExcludeIfNotInVersion(&tgc.Version)
+
+		if resourceToGenerate != "" && object.Name != resourceToGenerate {
+			log.Printf("Excluding %s per user request", object.Name)
+			continue
+		}
+
+		tgc.GenerateCaiToHclObject(*object, outputFolder)
+		tgc.GenerateRoundTripTest(*object, outputFolder)
+	}
+}
+
+// Generates the round-trip test of the resource examples, converting them
+// through tfplan2cai and cai2hcl.
+func (tgc TerraformGoogleConversionNext) GenerateRoundTripTest(object api.Resource, outputFolder string) {
+	if !hasCaiToHclConverter(object, &tgc.Version) || len(object.Examples) == 0 {
+		return
+	}
+
+	productName := tgc.Product.ApiName
+	fileName := fmt.Sprintf("%s_%s_roundtrip_test.go", productName, google.Underscore(object.Name))
+	target := path.Join("test/services", productName, fileName)
+
+	targetFolder := path.Join(outputFolder, path.Dir(target))
+	if err := os.MkdirAll(targetFolder, os.ModePerm); err != nil {
+		log.Println(fmt.Errorf("error creating parent directory %v: %v", targetFolder, err))
+	}
+
+	templateData := NewTemplateData(outputFolder, tgc.TargetVersionName)
+	templateData.GenerateTGCNextRoundTripTestFile(path.Join(outputFolder, target), object)
+	tgc.replaceImportPath(outputFolder, target)
+}
+
+func (tgc TerraformGoogleConversionNext) GenerateCaiToHclObject(object api.Resource, outputFolder string) {
+	if !hasCaiToHclConverter(object, &tgc.Version) {
+		return
+	}
+
+	productName := tgc.Product.ApiName
+	fileName := fmt.Sprintf("%s_%s.go", productName, google.Underscore(object.Name))
+	target := path.Join("cai2hcl/converters/services", productName, fileName)
+	if isHandwrittenCaiToHclConverter(path.Join("third_party/tgc_next", target)) {
+		log.Printf("Skipping %s, which has a handwritten cai2hcl converter", object.Name)
+		return
+	}
+
+	targetFolder := path.Join(outputFolder, path.Dir(target))
+	if err := os.MkdirAll(targetFolder, os.ModePerm); err != nil {
+		log.Println(fmt.Errorf("error creating parent directory %v: %v", targetFolder, err))
+	}
+
+	templateData := NewTemplateData(outputFolder, tgc.TargetVersionName)
+	templateData.GenerateTGCNextCaiToHclResourceFile(path.Join(outputFolder, target), object)
+	tgc.replaceImportPath(outputFolder, target)
 }
 
 func (tgc TerraformGoogleConversionNext) CompileCommonFiles(outputFolder string, products []*api.Product, overridePath string) {
@@ -87,10 +157,14 @@ func (tgc TerraformGoogleConversionNext) CompileTfToCaiCommonFiles(outputFolder
 }
 
 func (tgc TerraformGoogleConversionNext) CompileCaiToHclCommonFiles(outputFolder string, products []*api.Product) {
-	log.Printf("Compiling common files for tgc tfplan2cai.")
+	log.Printf("Compiling common files for tgc cai2hcl.")
+
+	tgc.CaiToHclConverters, tgc.CaiToHclServices = caiToHclConverters(products, tgc.TargetVersionName, "compute", "resourcemanager")
+	tgc.CaiToHclReferences = caiToHclReferences(products, tgc.TargetVersionName)
 
 	resourceConverters := map[string]string{
 		"cai2hcl/converters/resource_converters.go": "templates/tgc_next/cai2hcl/resource_converters.go.tmpl",
+		"cai2hcl/converters/resource_references.go": "templates/tgc_next/cai2hcl/resource_references.go.tmpl",
 	}
 	templateData := NewTemplateData(outputFolder, tgc.TargetVersionName)
 	tgc.CompileFileList(outputFolder, resourceConverters, *templateData, products)
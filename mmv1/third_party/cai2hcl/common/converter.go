@@ -2,7 +2,7 @@ package common
 
 import (
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/caiasset"
-	"github.com/zclconf/go-cty/cty"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/cai2hcl/models"
 )
 
 // Converter interface for resources.
@@ -11,8 +11,6 @@ type Converter interface {
 	Convert(asset []*caiasset.Asset) ([]*HCLResourceBlock, error)
 }
 
-// HCLResourceBlock identifies the HCL block's labels and content.
-type HCLResourceBlock struct {
-	Labels []string
-	Value  cty.Value
-}
+// HCLResourceBlock identifies the HCL block's labels and content. Blocks are
+// written by pkg/cai2hcl, which shares the block type.
+type HCLResourceBlock = models.TerraformResourceBlock
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/cai2hcl/converters/utils"
 	hashicorpcty "github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
@@ -23,6 +24,31 @@ func ParseFieldValue(url string, name string) string {
 	return ""
 }
 
+// ParseUrlParamValuesFromAssetName fills the fields missing from hclData with the
+// values matching the url parameters of the asset name template.
+func ParseUrlParamValuesFromAssetName(assetName, template string, hclData map[string]interface{}) error {
+	return utils.ParseUrlParamValuesFromAssetName(assetName, template, hclData)
+}
+
+// Remove the Terraform attribution label "goog-terraform-provisioned" from labels
+func RemoveTerraformAttributionLabel(raw interface{}) interface{} {
+	if raw == nil {
+		return nil
+	}
+
+	if labels, ok := raw.(map[string]string); ok {
+		delete(labels, "goog-terraform-provisioned")
+		return labels
+	}
+
+	if labels, ok := raw.(map[string]interface{}); ok {
+		delete(labels, "goog-terraform-provisioned")
+		return labels
+	}
+
+	return nil
+}
+
 // DecodeJSON decodes the map object into the target struct.
 func DecodeJSON(data map[string]interface{}, v interface{}) error {
 	b, err := json.Marshal(data)
@@ -2,10 +2,12 @@ package cai2hcl
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/caiasset"
 
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/cai2hcl/common"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/cai2hcl/models"
 	"go.uber.org/zap"
 )
 
@@ -13,6 +15,10 @@ import (
 // require updating function signatures all along the pipe.
 type Options struct {
 	ErrorLogger *zap.Logger
+
+	// Whether to emit an import block for each converted resource, so that
+	// the output can be applied to bring the resources under management.
+	EmitImportBlocks bool
 }
 
 // Converts CAI Assets into HCL string.
@@ -32,8 +38,17 @@ func Convert(assets []*caiasset.Asset, options *Options) ([]byte, error) {
 		}
 	}
 
+	// Convert the groups in a fixed order, so the same assets are always
+	// given the same resource addresses.
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
 	allBlocks := []*common.HCLResourceBlock{}
-	for name, assets := range groups {
+	for _, name := range names {
+		assets := groups[name]
 		converter, ok := ConverterMap[name]
 		if !ok {
 			continue
@@ -46,7 +61,14 @@ func Convert(assets []*caiasset.Asset, options *Options) ([]byte, error) {
 		allBlocks = append(allBlocks, newBlocks...)
 	}
 
-	t, err := common.HclWriteBlocks(allBlocks)
+	var t []byte
+	var err error
+	if options.EmitImportBlocks {
+		models.SetResourceAddresses(allBlocks)
+		t, err = models.HclWriteBlocksWithImports(allBlocks)
+	} else {
+		t, err = models.HclWriteBlocks(allBlocks)
+	}
 
 	options.ErrorLogger.Debug(string(t))
 
@@ -6,6 +6,7 @@ import (
 	"log"
 	"reflect"
 	"regexp"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/cai2hcl/common"
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/caiasset"
@@ -219,8 +220,9 @@ func (c *ComputeBackendServiceConverter) convertResourceData(asset *caiasset.Ass
 	resourceName := assetResourceData["name"].(string)
 
 	return &common.HCLResourceBlock{
-		Labels: []string{c.name, resourceName},
-		Value:  ctyVal,
+		Labels:   []string{c.name, resourceName},
+		Value:    ctyVal,
+		ImportId: strings.TrimPrefix(asset.Name, "//compute.googleapis.com/"),
 	}, nil
 }
 
@@ -87,8 +87,9 @@ func (c *ComputeForwardingRuleConverter) convertResourceData(asset *caiasset.Ass
 	resourceName := assetResourceData["name"].(string)
 
 	return &common.HCLResourceBlock{
-		Labels: []string{c.name, resourceName},
-		Value:  ctyVal,
+		Labels:   []string{c.name, resourceName},
+		Value:    ctyVal,
+		ImportId: strings.TrimPrefix(asset.Name, "//compute.googleapis.com/"),
 	}, nil
 }
 
@@ -3,6 +3,7 @@ package compute
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/caiasset"
 
@@ -132,8 +133,9 @@ func (c *ComputeInstanceConverter) convertResourceData(asset *caiasset.Asset) (*
 		return nil, err
 	}
 	return &common.HCLResourceBlock{
-		Labels: []string{c.name, instance.Name},
-		Value:  ctyVal,
+		Labels:   []string{c.name, instance.Name},
+		Value:    ctyVal,
+		ImportId: strings.TrimPrefix(asset.Name, "//compute.googleapis.com/"),
 	}, nil
 
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/cai2hcl/common"
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/caiasset"
@@ -159,8 +160,9 @@ func (c *ComputeRegionBackendServiceConverter) convertResourceData(asset *caiass
 	resourceName := assetResourceData["name"].(string)
 
 	return &common.HCLResourceBlock{
-		Labels: []string{c.name, resourceName},
-		Value:  ctyVal,
+		Labels:   []string{c.name, resourceName},
+		Value:    ctyVal,
+		ImportId: strings.TrimPrefix(asset.Name, "//compute.googleapis.com/"),
 	}, nil
 }
 
@@ -2,6 +2,7 @@ package compute
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/cai2hcl/common"
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/caiasset"
@@ -68,8 +69,9 @@ func (c *ComputeRegionHealthCheckConverter) convertResourceData(asset *caiasset.
 	resourceName := assetResourceData["name"].(string)
 
 	return &common.HCLResourceBlock{
-		Labels: []string{c.name, resourceName},
-		Value:  ctyVal,
+		Labels:   []string{c.name, resourceName},
+		Value:    ctyVal,
+		ImportId: strings.TrimPrefix(asset.Name, "//compute.googleapis.com/"),
 	}, nil
 }
 
@@ -138,7 +138,8 @@ func (c *ProjectConverter) convertResourceData(asset *caiasset.Asset) (*common.H
 		return nil, err
 	}
 	return &common.HCLResourceBlock{
-		Labels: []string{c.name, project.ProjectId},
-		Value:  ctyVal,
+		Labels:   []string{c.name, project.ProjectId},
+		Value:    ctyVal,
+		ImportId: project.ProjectId,
 	}, nil
 }
@@ -0,0 +1,122 @@
+package clouddeploy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+const testCustomTargetTypeName = "projects/my-project/locations/us-central1/customTargetTypes/my-type"
+
+// testCustomTargetTypeServer serves a custom target type whose etag is
+// "current", rejecting update and delete requests sent with another etag.
+func testCustomTargetTypeServer(t *testing.T) (*httptest.Server, *[]string) {
+	var mu sync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := r.Method + " " + r.URL.Path
+		if etag := r.URL.Query().Get("etag"); etag != "" {
+			request += "?etag=" + etag
+		}
+		mu.Lock()
+		requests = append(requests, request)
+		mu.Unlock()
+
+		if r.URL.Path != "/v1/"+testCustomTargetTypeName {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.RequestURI())
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+
+		etag := r.URL.Query().Get("etag")
+		switch r.Method {
+		case "GET":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"name":        testCustomTargetTypeName,
+				"description": "modified concurrently",
+				"etag":        "current",
+			})
+			return
+		case "PATCH":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("unable to decode update request: %s", err)
+			}
+			etag, _ = body["etag"].(string)
+		}
+		if etag != "current" {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"error": map[string]interface{}{"code": 412, "message": "etag mismatch", "status": "FAILED_PRECONDITION"},
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"name": "projects/my-project/locations/us-central1/operations/op",
+			"done": true,
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func testCustomTargetTypeData(t *testing.T, server *httptest.Server) (*schema.ResourceData, *transport_tpg.Config) {
+	d := schema.TestResourceDataRaw(t, ResourceClouddeployCustomTargetType().Schema, map[string]interface{}{
+		"project":     "my-project",
+		"location":    "us-central1",
+		"name":        "my-type",
+		"description": "planned",
+	})
+	d.SetId(testCustomTargetTypeName)
+	if err := d.Set("etag", "stale"); err != nil {
+		t.Fatalf("Error setting etag: %s", err)
+	}
+
+	return d, &transport_tpg.Config{
+		Client:              server.Client(),
+		ClouddeployBasePath: server.URL + "/v1/",
+	}
+}
+
+func TestUnitClouddeployCustomTargetType_updateEtagConflict(t *testing.T) {
+	server, requests := testCustomTargetTypeServer(t)
+	d, config := testCustomTargetTypeData(t, server)
+
+	err := resourceClouddeployCustomTargetTypeUpdate(d, config)
+	if err == nil || !strings.Contains(err.Error(), "plan again") {
+		t.Fatalf("expected error asking to refresh and plan again, got %v", err)
+	}
+	// The stale body must not be resent with a refreshed etag.
+	for _, r := range *requests {
+		if strings.HasPrefix(r, "GET") {
+			t.Errorf("expected no request to refresh the etag, got %v", *requests)
+		}
+	}
+	if len(*requests) != 1 {
+		t.Errorf("expected a single update request, got %v", *requests)
+	}
+}
+
+func TestUnitClouddeployCustomTargetType_deleteEtagConflict(t *testing.T) {
+	server, requests := testCustomTargetTypeServer(t)
+	d, config := testCustomTargetTypeData(t, server)
+
+	if err := resourceClouddeployCustomTargetTypeDelete(d, config); err != nil {
+		t.Fatalf("got unexpected error %s", err)
+	}
+	want := []string{
+		"DELETE /v1/" + testCustomTargetTypeName + "?etag=stale",
+		"GET /v1/" + testCustomTargetTypeName,
+		"DELETE /v1/" + testCustomTargetTypeName + "?etag=current",
+	}
+	if strings.Join(*requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("expected requests %v, got %v", want, *requests)
+	}
+}
@@ -34,6 +34,14 @@ func NewProjectIamUpdater(d tpgresource.TerraformResourceData, config *transport
 	}, nil
 }
 
+func NewProjectIamDenyUpdater(d tpgresource.TerraformResourceData, config *transport_tpg.Config) (tpgiamresource.ResourceIamDenyUpdater, error) {
+	u, err := NewProjectIamUpdater(d, config)
+	if err != nil {
+		return nil, err
+	}
+	return u.(*ProjectIamUpdater), nil
+}
+
 func ProjectIdParseFunc(d *schema.ResourceData, _ *transport_tpg.Config) error {
 	if err := d.Set("project", d.Id()); err != nil {
 		return fmt.Errorf("Error setting project: %s", err)
@@ -96,6 +104,14 @@ func (u *ProjectIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("project %q", u.resourceId)
 }
 
+func (u *ProjectIamUpdater) GetAttachmentPoint() string {
+	return fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", tpgresource.GetResourceNameFromSelfLink(u.resourceId))
+}
+
+func (u *ProjectIamUpdater) GetPolicyBindingParent() string {
+	return fmt.Sprintf("projects/%s", tpgresource.GetResourceNameFromSelfLink(u.resourceId))
+}
+
 func CompareProjectName(_, old, new string, _ *schema.ResourceData) bool {
 	// We can either get "projects/project-id" or "project-id", so strip any prefixes
 	return tpgresource.GetResourceNameFromSelfLink(old) == tpgresource.GetResourceNameFromSelfLink(new)
@@ -0,0 +1,23 @@
+package resourcemanager
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitProjectIamDenyUpdater(t *testing.T) {
+	for _, project := range []string{"my-project", "projects/my-project"} {
+		d := schema.TestResourceDataRaw(t, IamProjectSchema, map[string]interface{}{"project": project})
+		u, err := NewProjectIamDenyUpdater(d, nil)
+		if err != nil {
+			t.Fatalf("got unexpected error %s", err)
+		}
+		if got, want := u.GetAttachmentPoint(), "//cloudresourcemanager.googleapis.com/projects/my-project"; got != want {
+			t.Errorf("project %q: expected attachment point %q, got %q", project, want, got)
+		}
+		if got, want := u.GetPolicyBindingParent(), "projects/my-project"; got != want {
+			t.Errorf("project %q: expected policy binding parent %q, got %q", project, want, got)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package resourcemanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test that a deny policy can be attached to a project and updated
+func TestAccProjectIamDenyPolicy_update(t *testing.T) {
+	t.Parallel()
+
+	org := envvar.GetTestOrgFromEnv(t)
+	pid := fmt.Sprintf("tf-test-%d", acctest.RandInt(t))
+	policyId := fmt.Sprintf("tf-test-deny-%s", acctest.RandString(t, 10))
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectIamDenyPolicyBasic(pid, org, policyId),
+			},
+			{
+				ResourceName:      "google_project_iam_deny_policy.acceptance",
+				ImportStateId:     fmt.Sprintf("%s %s", pid, policyId),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectIamDenyPolicyUpdated(pid, org, policyId),
+			},
+			{
+				ResourceName:      "google_project_iam_deny_policy.acceptance",
+				ImportStateId:     fmt.Sprintf("%s %s", pid, policyId),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Test that a principal access boundary policy can be bound to the principals of a project
+func TestAccProjectIamPrincipalAccessBoundaryBinding_basic(t *testing.T) {
+	t.Parallel()
+
+	org := envvar.GetTestOrgFromEnv(t)
+	pid := fmt.Sprintf("tf-test-%d", acctest.RandInt(t))
+	bindingId := fmt.Sprintf("tf-test-pab-%s", acctest.RandString(t, 10))
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectIamPrincipalAccessBoundaryBindingBasic(pid, org, bindingId),
+			},
+			{
+				ResourceName:      "google_project_iam_principal_access_boundary_binding.acceptance",
+				ImportStateId:     fmt.Sprintf("%s %s", pid, bindingId),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectIamDenyPolicyBasic(pid, org, policyId string) string {
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  project_id      = "%s"
+  name            = "%s"
+  org_id          = "%s"
+  deletion_policy = "DELETE"
+}
+
+resource "google_project_iam_deny_policy" "acceptance" {
+  project        = google_project.acceptance.project_id
+  deny_policy_id = "%s"
+  rules {
+    denied_principals  = ["principal://goog/subject/admin@hashicorptest.com"]
+    denied_permissions = ["cloudresourcemanager.googleapis.com/projects.delete"]
+  }
+}
+`, pid, pid, org, policyId)
+}
+
+func testAccProjectIamDenyPolicyUpdated(pid, org, policyId string) string {
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  project_id      = "%s"
+  name            = "%s"
+  org_id          = "%s"
+  deletion_policy = "DELETE"
+}
+
+resource "google_project_iam_deny_policy" "acceptance" {
+  project        = google_project.acceptance.project_id
+  deny_policy_id = "%s"
+  display_name   = "Updated deny policy"
+  rules {
+    description          = "Deny everyone but one user"
+    denied_principals    = ["principalSet://goog/public:all"]
+    exception_principals = ["principal://goog/subject/admin@hashicorptest.com"]
+    denied_permissions   = ["cloudresourcemanager.googleapis.com/projects.delete"]
+    denial_condition {
+      title      = "Never"
+      expression = "false"
+    }
+  }
+}
+`, pid, pid, org, policyId)
+}
+
+func testAccProjectIamPrincipalAccessBoundaryBindingBasic(pid, org, bindingId string) string {
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  project_id      = "%s"
+  name            = "%s"
+  org_id          = "%s"
+  deletion_policy = "DELETE"
+}
+
+resource "google_iam_principal_access_boundary_policy" "acceptance" {
+  organization                        = "%s"
+  location                            = "global"
+  principal_access_boundary_policy_id = "%s"
+}
+
+resource "google_project_iam_principal_access_boundary_binding" "acceptance" {
+  project           = google_project.acceptance.project_id
+  policy_binding_id = "%s"
+  policy            = google_iam_principal_access_boundary_policy.acceptance.id
+}
+`, pid, pid, org, org, bindingId, bindingId)
+}
@@ -0,0 +1,163 @@
+package tpgiamresource
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// These types are implemented per GCP resource type and specify how to attach IAM deny
+// policies and principal access boundary (PAB) policy bindings to a resource. They are used
+// in the generic Terraform IAM resource definitions (e.g. _deny_policy/_principal_access_boundary_binding)
+type (
+	// The ResourceIamDenyUpdater interface is implemented for each GCP resource supporting IAM deny
+	// policies. Unlike ResourceIamUpdater, deny policies and policy bindings are standalone IAM API
+	// resources, so implementations only need to describe where they are attached.
+	ResourceIamDenyUpdater interface {
+		// Returns the full resource name deny policies are attached to.
+		// For example: `//cloudresourcemanager.googleapis.com/projects/my-project`.
+		GetAttachmentPoint() string
+
+		// Returns the parent policy bindings targeting this resource are created under.
+		// For example: `projects/my-project`.
+		GetPolicyBindingParent() string
+
+		// A mutex guards against concurrent changes to the policies attached to the resource.
+		// The mutex key should be made of the resource type and resource id.
+		GetMutexKey() string
+
+		// Returns the unique resource identifier.
+		GetResourceId() string
+
+		// Textual description of this resource to be used in error message.
+		// The description should include the unique resource identifier.
+		DescribeResource() string
+	}
+
+	// Factory for generating ResourceIamDenyUpdater for given ResourceData resource
+	NewResourceIamDenyUpdaterFunc func(d tpgresource.TerraformResourceData, config *transport_tpg.Config) (ResourceIamDenyUpdater, error)
+)
+
+var iamDenyConditionSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"expression": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	},
+}
+
+// Returns the IAM v2 URL of the deny policies attached to the resource. The attachment
+// point is a full resource name, which is URL-encoded into a single path segment
+// without its leading //, e.g.
+// policies/cloudresourcemanager.googleapis.com%2Fprojects%2Fmy-project/denypolicies
+func iamDenyPoliciesUrl(d tpgresource.TerraformResourceData, config *transport_tpg.Config, updater ResourceIamDenyUpdater) (string, error) {
+	attachmentPoint := strings.TrimPrefix(updater.GetAttachmentPoint(), "//")
+	return tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{IAM2BasePath}}policies/%s/denypolicies", url.PathEscape(attachmentPoint)))
+}
+
+// Returns the IAM v3 URL of the policy bindings created under the resource's binding parent.
+func iamPolicyBindingsUrl(d tpgresource.TerraformResourceData, config *transport_tpg.Config, updater ResourceIamDenyUpdater) (string, error) {
+	return tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{IAM3BasePath}}%s/locations/global/policyBindings", updater.GetPolicyBindingParent()))
+}
+
+// Splits the import id of a deny policy or policy binding into the resource in question
+// and the id of the attached policy, e.g. "projects/my-project/schemas/my-schema my-policy".
+func iamDenyImportId(d *schema.ResourceData, kind string) (string, string, error) {
+	s := strings.Fields(d.Id())
+	if len(s) != 2 {
+		d.SetId("")
+		return "", "", fmt.Errorf("Wrong number of parts to %s id %s; expected 'resource_name %s_id'.", kind, s, strings.ReplaceAll(kind, " ", "_"))
+	}
+	return s[0], s[1], nil
+}
+
+func flattenIamDenyCondition(v interface{}) []interface{} {
+	c, ok := v.(map[string]interface{})
+	if !ok || len(c) == 0 {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"expression":  c["expression"],
+		"title":       c["title"],
+		"description": c["description"],
+		"location":    c["location"],
+	}}
+}
+
+func expandIamDenyCondition(v interface{}) map[string]interface{} {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	raw := l[0].(map[string]interface{})
+	c := map[string]interface{}{
+		"expression": raw["expression"],
+	}
+	for _, k := range []string{"title", "description", "location"} {
+		if s, ok := raw[k].(string); ok && s != "" {
+			c[k] = s
+		}
+	}
+	return c
+}
+
+type iamDenyOperationWaiter struct {
+	Config    *transport_tpg.Config
+	UserAgent string
+	BasePath  string
+	tpgresource.CommonOperationWaiter
+}
+
+func (w *iamDenyOperationWaiter) QueryOp() (interface{}, error) {
+	if w == nil {
+		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
+	}
+	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    w.Config,
+		Method:    "GET",
+		RawURL:    w.BasePath + w.CommonOperationWaiter.Op.Name,
+		UserAgent: w.UserAgent,
+	})
+}
+
+// Waits for a long-running operation returned by the IAM v2 or v3 API. basePath is the
+// base path of the API version the operation was returned by.
+func iamDenyOperationWaitTime(config *transport_tpg.Config, op map[string]interface{}, basePath, activity, userAgent string, timeout time.Duration) error {
+	if val, ok := op["name"]; !ok || val == "" {
+		// This was a synchronous call - there is no operation to wait for.
+		return nil
+	}
+	w := &iamDenyOperationWaiter{
+		Config:    config,
+		UserAgent: userAgent,
+		BasePath:  basePath,
+	}
+	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
+		return err
+	}
+	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+}
@@ -0,0 +1,372 @@
+package tpgiamresource
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+var IamDenyPolicyBaseSchema = map[string]*schema.Schema{
+	"deny_policy_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"display_name": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"rules": {
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"denied_principals": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"exception_principals": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"denied_permissions": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"exception_permissions": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"denial_condition": iamDenyConditionSchema,
+			},
+		},
+	},
+	"etag": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func iamDenyPolicyImport(resourceIdParser ResourceIdParserFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		if resourceIdParser == nil {
+			return nil, errors.New("Import not supported for this IAM resource.")
+		}
+		config := m.(*transport_tpg.Config)
+		id, policyId, err := iamDenyImportId(d, "deny policy")
+		if err != nil {
+			return nil, err
+		}
+
+		// Set the ID only to the first part so all IAM types can share the same ResourceIdParserFunc.
+		d.SetId(id)
+		if err := d.Set("deny_policy_id", policyId); err != nil {
+			return nil, fmt.Errorf("Error setting deny_policy_id: %s", err)
+		}
+		if err := resourceIdParser(d, config); err != nil {
+			return nil, err
+		}
+
+		// Set the ID again so that the ID matches the ID it would have if it had been created via TF.
+		d.SetId(d.Id() + "/denyPolicies/" + policyId)
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func ResourceIamDenyPolicy(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc NewResourceIamDenyUpdaterFunc, resourceIdParser ResourceIdParserFunc, options ...func(*IamSettings)) *schema.Resource {
+	settings := NewIamSettings(options...)
+
+	return &schema.Resource{
+		Create: resourceIamDenyPolicyCreate(newUpdaterFunc),
+		Read:   resourceIamDenyPolicyRead(newUpdaterFunc),
+		Update: resourceIamDenyPolicyUpdate(newUpdaterFunc),
+		Delete: resourceIamDenyPolicyDelete(newUpdaterFunc),
+
+		// if non-empty, this will be used to send a deprecation message when the
+		// resource is used.
+		DeprecationMessage: settings.DeprecationMessage,
+
+		Schema: tpgresource.MergeSchemas(IamDenyPolicyBaseSchema, parentSpecificSchema),
+		Importer: &schema.ResourceImporter{
+			State: iamDenyPolicyImport(resourceIdParser),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceIamDenyPolicyCreate(newUpdaterFunc NewResourceIamDenyUpdaterFunc) schema.CreateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+		userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+		if err != nil {
+			return err
+		}
+
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		url, err := iamDenyPoliciesUrl(d, config, updater)
+		if err != nil {
+			return err
+		}
+		policyId := d.Get("deny_policy_id").(string)
+		url, err = transport_tpg.AddQueryParams(url, map[string]string{"policyId": policyId})
+		if err != nil {
+			return err
+		}
+
+		mutexKey := updater.GetMutexKey()
+		transport_tpg.MutexStore.Lock(mutexKey)
+		defer transport_tpg.MutexStore.Unlock(mutexKey)
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "POST",
+			RawURL:    url,
+			UserAgent: userAgent,
+			Body:      expandIamDenyPolicy(d),
+			Timeout:   d.Timeout(schema.TimeoutCreate),
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating deny policy %q for %s: %s", policyId, updater.DescribeResource(), err)
+		}
+
+		d.SetId(updater.GetResourceId() + "/denyPolicies/" + policyId)
+
+		basePath, err := tpgresource.ReplaceVars(d, config, "{{IAM2BasePath}}")
+		if err != nil {
+			return err
+		}
+		err = iamDenyOperationWaitTime(config, res, basePath, "Creating deny policy", userAgent, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			// The policy wasn't attached
+			d.SetId("")
+			return fmt.Errorf("Error waiting to create deny policy %q for %s: %s", policyId, updater.DescribeResource(), err)
+		}
+
+		return resourceIamDenyPolicyRead(newUpdaterFunc)(d, meta)
+	}
+}
+
+func resourceIamDenyPolicyRead(newUpdaterFunc NewResourceIamDenyUpdaterFunc) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+		userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+		if err != nil {
+			return err
+		}
+
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		url, err := iamDenyPoliciesUrl(d, config, updater)
+		if err != nil {
+			return err
+		}
+		policyId := d.Get("deny_policy_id").(string)
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			RawURL:    url + "/" + policyId,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Deny policy %q for %s", policyId, updater.DescribeResource()))
+		}
+
+		if err := d.Set("display_name", res["displayName"]); err != nil {
+			return fmt.Errorf("Error setting display_name: %s", err)
+		}
+		if err := d.Set("rules", flattenIamDenyPolicyRules(res["rules"])); err != nil {
+			return fmt.Errorf("Error setting rules: %s", err)
+		}
+		if err := d.Set("etag", res["etag"]); err != nil {
+			return fmt.Errorf("Error setting etag: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func resourceIamDenyPolicyUpdate(newUpdaterFunc NewResourceIamDenyUpdaterFunc) schema.UpdateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+		userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+		if err != nil {
+			return err
+		}
+
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		url, err := iamDenyPoliciesUrl(d, config, updater)
+		if err != nil {
+			return err
+		}
+		policyId := d.Get("deny_policy_id").(string)
+
+		// The etag guards against overwriting changes made since the policy was last read.
+		obj := expandIamDenyPolicy(d)
+		obj["etag"] = d.Get("etag")
+
+		mutexKey := updater.GetMutexKey()
+		transport_tpg.MutexStore.Lock(mutexKey)
+		defer transport_tpg.MutexStore.Unlock(mutexKey)
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "PUT",
+			RawURL:    url + "/" + policyId,
+			UserAgent: userAgent,
+			Body:      obj,
+			Timeout:   d.Timeout(schema.TimeoutUpdate),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating deny policy %q for %s: %s", policyId, updater.DescribeResource(), err)
+		}
+
+		basePath, err := tpgresource.ReplaceVars(d, config, "{{IAM2BasePath}}")
+		if err != nil {
+			return err
+		}
+		err = iamDenyOperationWaitTime(config, res, basePath, "Updating deny policy", userAgent, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+
+		return resourceIamDenyPolicyRead(newUpdaterFunc)(d, meta)
+	}
+}
+
+func resourceIamDenyPolicyDelete(newUpdaterFunc NewResourceIamDenyUpdaterFunc) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+		userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+		if err != nil {
+			return err
+		}
+
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		url, err := iamDenyPoliciesUrl(d, config, updater)
+		if err != nil {
+			return err
+		}
+		policyId := d.Get("deny_policy_id").(string)
+
+		mutexKey := updater.GetMutexKey()
+		transport_tpg.MutexStore.Lock(mutexKey)
+		defer transport_tpg.MutexStore.Unlock(mutexKey)
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "DELETE",
+			RawURL:    url + "/" + policyId,
+			UserAgent: userAgent,
+			Timeout:   d.Timeout(schema.TimeoutDelete),
+		})
+		if err != nil {
+			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Deny policy %q for %s", policyId, updater.DescribeResource()))
+		}
+
+		basePath, err := tpgresource.ReplaceVars(d, config, "{{IAM2BasePath}}")
+		if err != nil {
+			return err
+		}
+		return iamDenyOperationWaitTime(config, res, basePath, "Deleting deny policy", userAgent, d.Timeout(schema.TimeoutDelete))
+	}
+}
+
+func expandIamDenyPolicy(d tpgresource.TerraformResourceData) map[string]interface{} {
+	obj := map[string]interface{}{
+		"rules": expandIamDenyPolicyRules(d.Get("rules")),
+	}
+	if v, ok := d.GetOk("display_name"); ok {
+		obj["displayName"] = v
+	}
+	return obj
+}
+
+func expandIamDenyPolicyRules(v interface{}) []interface{} {
+	var rules []interface{}
+	for _, raw := range v.([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		r := raw.(map[string]interface{})
+		denyRule := map[string]interface{}{}
+		for k, apiKey := range map[string]string{
+			"denied_principals":     "deniedPrincipals",
+			"exception_principals":  "exceptionPrincipals",
+			"denied_permissions":    "deniedPermissions",
+			"exception_permissions": "exceptionPermissions",
+		} {
+			if s, ok := r[k].(*schema.Set); ok && s.Len() > 0 {
+				denyRule[apiKey] = tpgresource.ConvertStringSet(s)
+			}
+		}
+		if c := expandIamDenyCondition(r["denial_condition"]); c != nil {
+			denyRule["denialCondition"] = c
+		}
+		rule := map[string]interface{}{
+			"denyRule": denyRule,
+		}
+		if desc, ok := r["description"].(string); ok && desc != "" {
+			rule["description"] = desc
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenIamDenyPolicyRules(v interface{}) []interface{} {
+	l, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	var rules []interface{}
+	for _, raw := range l {
+		r, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		denyRule, _ := r["denyRule"].(map[string]interface{})
+		rules = append(rules, map[string]interface{}{
+			"description":           r["description"],
+			"denied_principals":     denyRule["deniedPrincipals"],
+			"exception_principals":  denyRule["exceptionPrincipals"],
+			"denied_permissions":    denyRule["deniedPermissions"],
+			"exception_permissions": denyRule["exceptionPermissions"],
+			"denial_condition":      flattenIamDenyCondition(denyRule["denialCondition"]),
+		})
+	}
+	return rules
+}
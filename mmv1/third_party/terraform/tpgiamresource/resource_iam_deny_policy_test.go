@@ -0,0 +1,139 @@
+package tpgiamresource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestIamDenyPolicyExpandRules(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, IamDenyPolicyBaseSchema, map[string]interface{}{
+		"deny_policy_id": "my-policy",
+		"display_name":   "My policy",
+		"rules": []interface{}{
+			map[string]interface{}{
+				"description":        "deny deletes",
+				"denied_principals":  []interface{}{"principalSet://goog/public:all"},
+				"denied_permissions": []interface{}{"cloudresourcemanager.googleapis.com/projects.delete"},
+				"denial_condition": []interface{}{
+					map[string]interface{}{
+						"title":      "prod only",
+						"expression": "resource.matchTag('12345678/env', 'prod')",
+					},
+				},
+			},
+		},
+	})
+
+	expected := map[string]interface{}{
+		"displayName": "My policy",
+		"rules": []interface{}{
+			map[string]interface{}{
+				"description": "deny deletes",
+				"denyRule": map[string]interface{}{
+					"deniedPrincipals":  []string{"principalSet://goog/public:all"},
+					"deniedPermissions": []string{"cloudresourcemanager.googleapis.com/projects.delete"},
+					"denialCondition": map[string]interface{}{
+						"title":      "prod only",
+						"expression": "resource.matchTag('12345678/env', 'prod')",
+					},
+				},
+			},
+		},
+	}
+
+	if got := expandIamDenyPolicy(d); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func TestIamDenyPolicyFlattenRules(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{
+			"denyRule": map[string]interface{}{
+				"deniedPrincipals":    []interface{}{"principalSet://goog/public:all"},
+				"exceptionPrincipals": []interface{}{"principal://goog/subject/admin@example.com"},
+				"deniedPermissions":   []interface{}{"cloudresourcemanager.googleapis.com/projects.delete"},
+			},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"description":           nil,
+			"denied_principals":     []interface{}{"principalSet://goog/public:all"},
+			"exception_principals":  []interface{}{"principal://goog/subject/admin@example.com"},
+			"denied_permissions":    []interface{}{"cloudresourcemanager.googleapis.com/projects.delete"},
+			"exception_permissions": nil,
+			"denial_condition":      []interface{}(nil),
+		},
+	}
+
+	if got := flattenIamDenyPolicyRules(rules); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+}
+
+type testIamDenyUpdater struct {
+	attachmentPoint     string
+	policyBindingParent string
+}
+
+func (u testIamDenyUpdater) GetAttachmentPoint() string     { return u.attachmentPoint }
+func (u testIamDenyUpdater) GetPolicyBindingParent() string { return u.policyBindingParent }
+func (u testIamDenyUpdater) GetMutexKey() string            { return "iam-test" }
+func (u testIamDenyUpdater) GetResourceId() string          { return u.policyBindingParent }
+func (u testIamDenyUpdater) DescribeResource() string       { return u.policyBindingParent }
+
+func TestIamDenyPolicyUrls(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, IamDenyPolicyBaseSchema, map[string]interface{}{})
+	config := &transport_tpg.Config{
+		IAM2BasePath: "https://iam.googleapis.com/v2/",
+		IAM3BasePath: "https://iam.googleapis.com/v3/",
+	}
+
+	cases := map[string]struct {
+		updater           testIamDenyUpdater
+		denyPoliciesUrl   string
+		policyBindingsUrl string
+	}{
+		"project": {
+			updater: testIamDenyUpdater{
+				attachmentPoint:     "//cloudresourcemanager.googleapis.com/projects/my-project",
+				policyBindingParent: "projects/my-project",
+			},
+			denyPoliciesUrl:   "https://iam.googleapis.com/v2/policies/cloudresourcemanager.googleapis.com%2Fprojects%2Fmy-project/denypolicies",
+			policyBindingsUrl: "https://iam.googleapis.com/v3/projects/my-project/locations/global/policyBindings",
+		},
+		"organization": {
+			updater: testIamDenyUpdater{
+				attachmentPoint:     "//cloudresourcemanager.googleapis.com/organizations/123",
+				policyBindingParent: "organizations/123",
+			},
+			denyPoliciesUrl:   "https://iam.googleapis.com/v2/policies/cloudresourcemanager.googleapis.com%2Forganizations%2F123/denypolicies",
+			policyBindingsUrl: "https://iam.googleapis.com/v3/organizations/123/locations/global/policyBindings",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := iamDenyPoliciesUrl(d, config, tc.updater)
+			if err != nil {
+				t.Fatalf("got unexpected error %s", err)
+			}
+			if got != tc.denyPoliciesUrl {
+				t.Errorf("expected deny policies url %q, got %q", tc.denyPoliciesUrl, got)
+			}
+
+			got, err = iamPolicyBindingsUrl(d, config, tc.updater)
+			if err != nil {
+				t.Fatalf("got unexpected error %s", err)
+			}
+			if got != tc.policyBindingsUrl {
+				t.Errorf("expected policy bindings url %q, got %q", tc.policyBindingsUrl, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,326 @@
+package tpgiamresource
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+var IamPrincipalAccessBoundaryBindingBaseSchema = map[string]*schema.Schema{
+	"policy_binding_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"policy": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: `The name of the principal access boundary policy to bind, in the format organizations/{organization}/locations/global/principalAccessBoundaryPolicies/{policy}.`,
+	},
+	"display_name": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"condition": iamDenyConditionSchema,
+	"etag": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"uid": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func iamPrincipalAccessBoundaryBindingImport(resourceIdParser ResourceIdParserFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		if resourceIdParser == nil {
+			return nil, errors.New("Import not supported for this IAM resource.")
+		}
+		config := m.(*transport_tpg.Config)
+		id, bindingId, err := iamDenyImportId(d, "policy binding")
+		if err != nil {
+			return nil, err
+		}
+
+		// Set the ID only to the first part so all IAM types can share the same ResourceIdParserFunc.
+		d.SetId(id)
+		if err := d.Set("policy_binding_id", bindingId); err != nil {
+			return nil, fmt.Errorf("Error setting policy_binding_id: %s", err)
+		}
+		if err := resourceIdParser(d, config); err != nil {
+			return nil, err
+		}
+
+		// Set the ID again so that the ID matches the ID it would have if it had been created via TF.
+		d.SetId(d.Id() + "/policyBindings/" + bindingId)
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// Binds a principal access boundary policy to the principal set of a resource, so that
+// the policy applies to every principal the resource contains.
+func ResourceIamPrincipalAccessBoundaryBinding(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc NewResourceIamDenyUpdaterFunc, resourceIdParser ResourceIdParserFunc, options ...func(*IamSettings)) *schema.Resource {
+	settings := NewIamSettings(options...)
+
+	return &schema.Resource{
+		Create: resourceIamPrincipalAccessBoundaryBindingCreate(newUpdaterFunc),
+		Read:   resourceIamPrincipalAccessBoundaryBindingRead(newUpdaterFunc),
+		Update: resourceIamPrincipalAccessBoundaryBindingUpdate(newUpdaterFunc),
+		Delete: resourceIamPrincipalAccessBoundaryBindingDelete(newUpdaterFunc),
+
+		// if non-empty, this will be used to send a deprecation message when the
+		// resource is used.
+		DeprecationMessage: settings.DeprecationMessage,
+
+		Schema: tpgresource.MergeSchemas(IamPrincipalAccessBoundaryBindingBaseSchema, parentSpecificSchema),
+		Importer: &schema.ResourceImporter{
+			State: iamPrincipalAccessBoundaryBindingImport(resourceIdParser),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceIamPrincipalAccessBoundaryBindingCreate(newUpdaterFunc NewResourceIamDenyUpdaterFunc) schema.CreateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+		userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+		if err != nil {
+			return err
+		}
+
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		url, err := iamPolicyBindingsUrl(d, config, updater)
+		if err != nil {
+			return err
+		}
+		bindingId := d.Get("policy_binding_id").(string)
+		url, err = transport_tpg.AddQueryParams(url, map[string]string{"policyBindingId": bindingId})
+		if err != nil {
+			return err
+		}
+
+		obj := expandIamPrincipalAccessBoundaryBinding(d)
+		obj["policyKind"] = "PRINCIPAL_ACCESS_BOUNDARY"
+		obj["policy"] = d.Get("policy")
+		obj["target"] = map[string]interface{}{
+			"principalSet": updater.GetAttachmentPoint(),
+		}
+
+		mutexKey := updater.GetMutexKey()
+		transport_tpg.MutexStore.Lock(mutexKey)
+		defer transport_tpg.MutexStore.Unlock(mutexKey)
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "POST",
+			RawURL:    url,
+			UserAgent: userAgent,
+			Body:      obj,
+			Timeout:   d.Timeout(schema.TimeoutCreate),
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating policy binding %q for %s: %s", bindingId, updater.DescribeResource(), err)
+		}
+
+		d.SetId(updater.GetResourceId() + "/policyBindings/" + bindingId)
+
+		basePath, err := tpgresource.ReplaceVars(d, config, "{{IAM3BasePath}}")
+		if err != nil {
+			return err
+		}
+		err = iamDenyOperationWaitTime(config, res, basePath, "Creating policy binding", userAgent, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			// The policy wasn't bound
+			d.SetId("")
+			return fmt.Errorf("Error waiting to create policy binding %q for %s: %s", bindingId, updater.DescribeResource(), err)
+		}
+
+		return resourceIamPrincipalAccessBoundaryBindingRead(newUpdaterFunc)(d, meta)
+	}
+}
+
+func resourceIamPrincipalAccessBoundaryBindingRead(newUpdaterFunc NewResourceIamDenyUpdaterFunc) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+		userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+		if err != nil {
+			return err
+		}
+
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		url, err := iamPolicyBindingsUrl(d, config, updater)
+		if err != nil {
+			return err
+		}
+		bindingId := d.Get("policy_binding_id").(string)
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			RawURL:    url + "/" + bindingId,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Policy binding %q for %s", bindingId, updater.DescribeResource()))
+		}
+
+		if err := d.Set("policy", res["policy"]); err != nil {
+			return fmt.Errorf("Error setting policy: %s", err)
+		}
+		if err := d.Set("display_name", res["displayName"]); err != nil {
+			return fmt.Errorf("Error setting display_name: %s", err)
+		}
+		if err := d.Set("condition", flattenIamDenyCondition(res["condition"])); err != nil {
+			return fmt.Errorf("Error setting condition: %s", err)
+		}
+		if err := d.Set("etag", res["etag"]); err != nil {
+			return fmt.Errorf("Error setting etag: %s", err)
+		}
+		if err := d.Set("uid", res["uid"]); err != nil {
+			return fmt.Errorf("Error setting uid: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func resourceIamPrincipalAccessBoundaryBindingUpdate(newUpdaterFunc NewResourceIamDenyUpdaterFunc) schema.UpdateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+		userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+		if err != nil {
+			return err
+		}
+
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		url, err := iamPolicyBindingsUrl(d, config, updater)
+		if err != nil {
+			return err
+		}
+		bindingId := d.Get("policy_binding_id").(string)
+
+		updateMask := []string{}
+		if d.HasChange("display_name") {
+			updateMask = append(updateMask, "displayName")
+		}
+		if d.HasChange("condition") {
+			updateMask = append(updateMask, "condition")
+		}
+		if len(updateMask) == 0 {
+			return resourceIamPrincipalAccessBoundaryBindingRead(newUpdaterFunc)(d, meta)
+		}
+		url, err = transport_tpg.AddQueryParams(url+"/"+bindingId, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+		if err != nil {
+			return err
+		}
+
+		// The etag guards against overwriting changes made since the binding was last read.
+		obj := expandIamPrincipalAccessBoundaryBinding(d)
+		obj["etag"] = d.Get("etag")
+
+		mutexKey := updater.GetMutexKey()
+		transport_tpg.MutexStore.Lock(mutexKey)
+		defer transport_tpg.MutexStore.Unlock(mutexKey)
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "PATCH",
+			RawURL:    url,
+			UserAgent: userAgent,
+			Body:      obj,
+			Timeout:   d.Timeout(schema.TimeoutUpdate),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating policy binding %q for %s: %s", bindingId, updater.DescribeResource(), err)
+		}
+
+		basePath, err := tpgresource.ReplaceVars(d, config, "{{IAM3BasePath}}")
+		if err != nil {
+			return err
+		}
+		err = iamDenyOperationWaitTime(config, res, basePath, "Updating policy binding", userAgent, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+
+		return resourceIamPrincipalAccessBoundaryBindingRead(newUpdaterFunc)(d, meta)
+	}
+}
+
+func resourceIamPrincipalAccessBoundaryBindingDelete(newUpdaterFunc NewResourceIamDenyUpdaterFunc) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+		userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+		if err != nil {
+			return err
+		}
+
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		url, err := iamPolicyBindingsUrl(d, config, updater)
+		if err != nil {
+			return err
+		}
+		bindingId := d.Get("policy_binding_id").(string)
+
+		mutexKey := updater.GetMutexKey()
+		transport_tpg.MutexStore.Lock(mutexKey)
+		defer transport_tpg.MutexStore.Unlock(mutexKey)
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "DELETE",
+			RawURL:    url + "/" + bindingId,
+			UserAgent: userAgent,
+			Timeout:   d.Timeout(schema.TimeoutDelete),
+		})
+		if err != nil {
+			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Policy binding %q for %s", bindingId, updater.DescribeResource()))
+		}
+
+		basePath, err := tpgresource.ReplaceVars(d, config, "{{IAM3BasePath}}")
+		if err != nil {
+			return err
+		}
+		return iamDenyOperationWaitTime(config, res, basePath, "Deleting policy binding", userAgent, d.Timeout(schema.TimeoutDelete))
+	}
+}
+
+func expandIamPrincipalAccessBoundaryBinding(d tpgresource.TerraformResourceData) map[string]interface{} {
+	obj := map[string]interface{}{}
+	if v, ok := d.GetOk("display_name"); ok {
+		obj["displayName"] = v
+	}
+	if c := expandIamDenyCondition(d.Get("condition")); c != nil {
+		obj["condition"] = c
+	}
+	return obj
+}
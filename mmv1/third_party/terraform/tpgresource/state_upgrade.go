@@ -0,0 +1,187 @@
+package tpgresource
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// The helpers in this file implement the declarative `state_migrations` steps
+// of MMv1 resources. They operate on the JSON form of a resource's state, where
+// nested blocks are stored as lists of objects. Field paths are dot-separated
+// Terraform field names, e.g. "settings.tier"; a path segment that holds a
+// list of blocks is applied to every element of the list.
+
+// stateUpgradeParents returns every object in rawState that holds the last
+// segment of path, along with that segment.
+func stateUpgradeParents(rawState map[string]interface{}, path string) ([]map[string]interface{}, string) {
+	parts := strings.Split(path, ".")
+	parents := []map[string]interface{}{rawState}
+	for _, part := range parts[:len(parts)-1] {
+		var next []map[string]interface{}
+		for _, p := range parents {
+			switch v := p[part].(type) {
+			case map[string]interface{}:
+				next = append(next, v)
+			case []interface{}:
+				for _, e := range v {
+					if m, ok := e.(map[string]interface{}); ok {
+						next = append(next, m)
+					}
+				}
+			}
+		}
+		parents = next
+	}
+	return parents, parts[len(parts)-1]
+}
+
+// RenameStateField moves the value stored at path to the sibling field newName.
+func RenameStateField(rawState map[string]interface{}, path, newName string) (map[string]interface{}, error) {
+	parents, field := stateUpgradeParents(rawState, path)
+	for _, p := range parents {
+		v, ok := p[field]
+		if !ok {
+			continue
+		}
+		if p[newName] != nil {
+			return nil, fmt.Errorf("cannot rename %q to %q: %q is already set in state", path, newName, newName)
+		}
+		p[newName] = v
+		delete(p, field)
+	}
+
+	log.Printf("[DEBUG] Attributes after renaming %s to %s: %#v", path, newName, rawState)
+	return rawState, nil
+}
+
+// MoveStateFieldsIntoBlock moves the given sibling fields into a new nested
+// block named block, stored as a list of one object.
+func MoveStateFieldsIntoBlock(rawState map[string]interface{}, fields []string, block string) (map[string]interface{}, error) {
+	if len(fields) == 0 {
+		return rawState, nil
+	}
+
+	parents, _ := stateUpgradeParents(rawState, fields[0])
+	prefix := ""
+	if i := strings.LastIndex(fields[0], "."); i >= 0 {
+		prefix = fields[0][:i+1]
+	}
+
+	for _, p := range parents {
+		moved := make(map[string]interface{})
+		for _, f := range fields {
+			name := strings.TrimPrefix(f, prefix)
+			if v, ok := p[name]; ok {
+				if v != nil {
+					moved[name] = v
+				}
+				delete(p, name)
+			}
+		}
+		if len(moved) == 0 {
+			continue
+		}
+		if p[block] != nil {
+			return nil, fmt.Errorf("cannot move fields into %q: %q is already set in state", prefix+block, prefix+block)
+		}
+		p[block] = []interface{}{moved}
+	}
+
+	log.Printf("[DEBUG] Attributes after moving %v into %s: %#v", fields, block, rawState)
+	return rawState, nil
+}
+
+// FlattenStateList replaces a list of at most one element stored at path with
+// that element. An empty list removes the field from state.
+func FlattenStateList(rawState map[string]interface{}, path string) (map[string]interface{}, error) {
+	parents, field := stateUpgradeParents(rawState, path)
+	for _, p := range parents {
+		l, ok := p[field].([]interface{})
+		if !ok {
+			continue
+		}
+		switch len(l) {
+		case 0:
+			delete(p, field)
+		case 1:
+			p[field] = l[0]
+		default:
+			return nil, fmt.Errorf("cannot flatten %q: expected at most one element, got %d", path, len(l))
+		}
+	}
+
+	log.Printf("[DEBUG] Attributes after flattening %s: %#v", path, rawState)
+	return rawState, nil
+}
+
+// RenameStateEnumValue replaces oldValue with newValue for the string field
+// stored at path. Lists and sets of strings are renamed element-wise.
+func RenameStateEnumValue(rawState map[string]interface{}, path, oldValue, newValue string) (map[string]interface{}, error) {
+	parents, field := stateUpgradeParents(rawState, path)
+	for _, p := range parents {
+		switch v := p[field].(type) {
+		case string:
+			if v == oldValue {
+				p[field] = newValue
+			}
+		case []interface{}:
+			for i, e := range v {
+				if s, ok := e.(string); ok && s == oldValue {
+					v[i] = newValue
+				}
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Attributes after renaming %s value %s to %s: %#v", path, oldValue, newValue, rawState)
+	return rawState, nil
+}
+
+// ConvertStateUnit multiplies the numeric value stored at path by multiplier,
+// e.g. 1000 to convert seconds to milliseconds. Values keep their JSON
+// representation, so numbers stored as strings stay strings.
+func ConvertStateUnit(rawState map[string]interface{}, path string, multiplier float64) (map[string]interface{}, error) {
+	parents, field := stateUpgradeParents(rawState, path)
+	for _, p := range parents {
+		v, ok := p[field]
+		if !ok || v == nil {
+			continue
+		}
+		converted, err := convertStateNumber(v, multiplier)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert %q: %w", path, err)
+		}
+		p[field] = converted
+	}
+
+	log.Printf("[DEBUG] Attributes after converting %s by %v: %#v", path, multiplier, rawState)
+	return rawState, nil
+}
+
+func convertStateNumber(v interface{}, multiplier float64) (interface{}, error) {
+	switch n := v.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return json.Number(strconv.FormatFloat(f*multiplier, 'f', -1, 64)), nil
+	case float64:
+		return n * multiplier, nil
+	case int:
+		return float64(n) * multiplier, nil
+	case string:
+		if n == "" {
+			return n, nil
+		}
+		f, err := strconv.ParseFloat(n, 64)
+		if err != nil {
+			return nil, err
+		}
+		return strconv.FormatFloat(f*multiplier, 'f', -1, 64), nil
+	}
+	return nil, fmt.Errorf("unexpected type %T", v)
+}
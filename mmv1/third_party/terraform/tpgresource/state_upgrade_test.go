@@ -0,0 +1,232 @@
+package tpgresource_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+)
+
+func TestRenameStateField(t *testing.T) {
+	cases := map[string]struct {
+		state     map[string]interface{}
+		path      string
+		newName   string
+		want      map[string]interface{}
+		wantError bool
+	}{
+		"top-level field": {
+			state:   map[string]interface{}{"old": "a", "other": "b"},
+			path:    "old",
+			newName: "new",
+			want:    map[string]interface{}{"new": "a", "other": "b"},
+		},
+		"field in a nested block": {
+			state: map[string]interface{}{
+				"settings": []interface{}{
+					map[string]interface{}{"old": "a"},
+					map[string]interface{}{"old": "b"},
+				},
+			},
+			path:    "settings.old",
+			newName: "new",
+			want: map[string]interface{}{
+				"settings": []interface{}{
+					map[string]interface{}{"new": "a"},
+					map[string]interface{}{"new": "b"},
+				},
+			},
+		},
+		"missing field": {
+			state:   map[string]interface{}{"other": "b"},
+			path:    "old",
+			newName: "new",
+			want:    map[string]interface{}{"other": "b"},
+		},
+		"new field already set": {
+			state:     map[string]interface{}{"old": "a", "new": "b"},
+			path:      "old",
+			newName:   "new",
+			wantError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got, err := tpgresource.RenameStateField(tc.state, tc.path, tc.newName)
+			if (err != nil) != tc.wantError {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.wantError && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("want %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMoveStateFieldsIntoBlock(t *testing.T) {
+	cases := map[string]struct {
+		state     map[string]interface{}
+		fields    []string
+		block     string
+		want      map[string]interface{}
+		wantError bool
+	}{
+		"top-level fields": {
+			state:  map[string]interface{}{"a": "1", "b": "2", "c": "3"},
+			fields: []string{"a", "b"},
+			block:  "config",
+			want: map[string]interface{}{
+				"c":      "3",
+				"config": []interface{}{map[string]interface{}{"a": "1", "b": "2"}},
+			},
+		},
+		"nested fields": {
+			state: map[string]interface{}{
+				"spec": []interface{}{map[string]interface{}{"a": "1"}},
+			},
+			fields: []string{"spec.a"},
+			block:  "config",
+			want: map[string]interface{}{
+				"spec": []interface{}{map[string]interface{}{
+					"config": []interface{}{map[string]interface{}{"a": "1"}},
+				}},
+			},
+		},
+		"unset fields": {
+			state:  map[string]interface{}{"a": nil, "c": "3"},
+			fields: []string{"a", "b"},
+			block:  "config",
+			want:   map[string]interface{}{"c": "3"},
+		},
+		"block already set": {
+			state:     map[string]interface{}{"a": "1", "config": []interface{}{}},
+			fields:    []string{"a"},
+			block:     "config",
+			wantError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got, err := tpgresource.MoveStateFieldsIntoBlock(tc.state, tc.fields, tc.block)
+			if (err != nil) != tc.wantError {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.wantError && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("want %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFlattenStateList(t *testing.T) {
+	cases := map[string]struct {
+		state     map[string]interface{}
+		want      map[string]interface{}
+		wantError bool
+	}{
+		"list of one": {
+			state: map[string]interface{}{"field": []interface{}{"a"}},
+			want:  map[string]interface{}{"field": "a"},
+		},
+		"empty list": {
+			state: map[string]interface{}{"field": []interface{}{}},
+			want:  map[string]interface{}{},
+		},
+		"already flattened": {
+			state: map[string]interface{}{"field": "a"},
+			want:  map[string]interface{}{"field": "a"},
+		},
+		"list of many": {
+			state:     map[string]interface{}{"field": []interface{}{"a", "b"}},
+			wantError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got, err := tpgresource.FlattenStateList(tc.state, "field")
+			if (err != nil) != tc.wantError {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.wantError && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("want %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRenameStateEnumValue(t *testing.T) {
+	cases := map[string]struct {
+		state map[string]interface{}
+		want  map[string]interface{}
+	}{
+		"matching value": {
+			state: map[string]interface{}{"tier": "STANDARD"},
+			want:  map[string]interface{}{"tier": "BASIC"},
+		},
+		"other value": {
+			state: map[string]interface{}{"tier": "PREMIUM"},
+			want:  map[string]interface{}{"tier": "PREMIUM"},
+		},
+		"list of values": {
+			state: map[string]interface{}{"tier": []interface{}{"STANDARD", "PREMIUM"}},
+			want:  map[string]interface{}{"tier": []interface{}{"BASIC", "PREMIUM"}},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got, err := tpgresource.RenameStateEnumValue(tc.state, "tier", "STANDARD", "BASIC")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("want %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestConvertStateUnit(t *testing.T) {
+	cases := map[string]struct {
+		state     map[string]interface{}
+		want      map[string]interface{}
+		wantError bool
+	}{
+		"json number": {
+			state: map[string]interface{}{"timeout": json.Number("1.5")},
+			want:  map[string]interface{}{"timeout": json.Number("1500")},
+		},
+		"float": {
+			state: map[string]interface{}{"timeout": float64(2)},
+			want:  map[string]interface{}{"timeout": float64(2000)},
+		},
+		"numeric string": {
+			state: map[string]interface{}{"timeout": "3"},
+			want:  map[string]interface{}{"timeout": "3000"},
+		},
+		"unset": {
+			state: map[string]interface{}{"timeout": nil},
+			want:  map[string]interface{}{"timeout": nil},
+		},
+		"non-numeric string": {
+			state:     map[string]interface{}{"timeout": "3s"},
+			wantError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got, err := tpgresource.ConvertStateUnit(tc.state, "timeout", 1000)
+			if (err != nil) != tc.wantError {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.wantError && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("want %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
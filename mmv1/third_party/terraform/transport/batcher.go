@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -334,3 +336,68 @@ func (req *BatchRequest) send() batchResponse {
 	v, err := req.SendF(req.ResourceName, req.Body)
 	return batchResponse{v, err}
 }
+
+// CombineListAppendBatches returns a BatcherCombineFunc for request bodies
+// shaped like {listKey: [entries...]}, such as the requests of batchCreate
+// methods. The entries of toAdd are appended to those of body.
+func CombineListAppendBatches(listKey string) BatcherCombineFunc {
+	return func(body interface{}, toAdd interface{}) (interface{}, error) {
+		bodyObj, ok := body.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Expected batch body type to be map[string]interface{}, got %T. This is a provider error.", body)
+		}
+		toAddObj, ok := toAdd.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Expected new request body type to be map[string]interface{}, got %T. This is a provider error.", toAdd)
+		}
+
+		entries, ok := bodyObj[listKey].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Expected batch body field %q to be a list, got %T. This is a provider error.", listKey, bodyObj[listKey])
+		}
+		toAddEntries, ok := toAddObj[listKey].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Expected new request body field %q to be a list, got %T. This is a provider error.", listKey, toAddObj[listKey])
+		}
+
+		combined := make(map[string]interface{}, len(bodyObj))
+		for k, v := range bodyObj {
+			combined[k] = v
+		}
+		combined[listKey] = append(append([]interface{}{}, entries...), toAddEntries...)
+		return combined, nil
+	}
+}
+
+// BatchKeyWithRequestOptions extends batchKey with the billing project, user
+// agent and headers a batch is sent with, so that only requests sharing them
+// are combined into a single request.
+func BatchKeyWithRequestOptions(batchKey, billingProject, userAgent string, headers http.Header) string {
+	// fmt prints maps sorted by key, so equal headers give equal keys.
+	return fmt.Sprintf("%s billingProject=%q userAgent=%q headers=%v", batchKey, billingProject, userAgent, headers)
+}
+
+// BatchResponseEntry returns the entry of the list listKey in a combined batch
+// response that belongs to a single request, i.e. whose idField is id or a
+// resource name ending in id. An error is returned if the response holds no
+// such entry, such as when the batch partially failed.
+func BatchResponseEntry(res interface{}, listKey, idField, id string) (map[string]interface{}, error) {
+	resObj, ok := res.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Expected batch response type to be map[string]interface{}, got %T. This is a provider error.", res)
+	}
+	entries, ok := resObj[listKey].([]interface{})
+	if !ok && resObj[listKey] != nil {
+		return nil, fmt.Errorf("Expected batch response field %q to be a list, got %T. This is a provider error.", listKey, resObj[listKey])
+	}
+	for _, e := range entries {
+		entry, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if v, ok := entry[idField].(string); ok && (v == id || strings.HasSuffix(v, "/"+id)) {
+			return entry, nil
+		}
+	}
+	return nil, fmt.Errorf("batch response has no entry with %s %q", idField, id)
+}
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -292,3 +295,192 @@ func testBasicCountBatches(t *testing.T, testName string, numBatches int) {
 		}(i)
 	}
 }
+
+func TestCombineListAppendBatches(t *testing.T) {
+	combine := CombineListAppendBatches("requests")
+
+	body := map[string]interface{}{
+		"parent":   "projects/p",
+		"requests": []interface{}{"a"},
+	}
+	combined, err := combine(body, map[string]interface{}{"requests": []interface{}{"b", "c"}})
+	if err != nil {
+		t.Fatalf("got unexpected error %s", err)
+	}
+
+	want := map[string]interface{}{
+		"parent":   "projects/p",
+		"requests": []interface{}{"a", "b", "c"},
+	}
+	if !reflect.DeepEqual(combined, want) {
+		t.Errorf("expected combined body %v, got %v", want, combined)
+	}
+	if len(body["requests"].([]interface{})) != 1 {
+		t.Errorf("expected original body to be unchanged, got %v", body)
+	}
+
+	if _, err := combine(body, []string{"b"}); err == nil {
+		t.Errorf("expected error combining body of wrong type")
+	}
+	if _, err := combine(map[string]interface{}{}, body); err == nil {
+		t.Errorf("expected error combining body without list field")
+	}
+}
+
+func TestBatchKeyWithRequestOptions(t *testing.T) {
+	key := BatchKeyWithRequestOptions("projects/p/widgets:batchCreate", "p", "ua", http.Header{"B": {"2"}, "A": {"1"}})
+	if got := BatchKeyWithRequestOptions("projects/p/widgets:batchCreate", "p", "ua", http.Header{"A": {"1"}, "B": {"2"}}); got != key {
+		t.Errorf("expected equal headers to give key %q, got %q", key, got)
+	}
+
+	for _, other := range []string{
+		BatchKeyWithRequestOptions("projects/p/widgets:batchCreate", "other", "ua", http.Header{"A": {"1"}, "B": {"2"}}),
+		BatchKeyWithRequestOptions("projects/p/widgets:batchCreate", "p", "other", http.Header{"A": {"1"}, "B": {"2"}}),
+		BatchKeyWithRequestOptions("projects/p/widgets:batchCreate", "p", "ua", http.Header{"A": {"1"}}),
+	} {
+		if other == key {
+			t.Errorf("expected key %q to differ from %q", other, key)
+		}
+	}
+}
+
+func TestBatchResponseEntry(t *testing.T) {
+	res := map[string]interface{}{
+		"widgets": []interface{}{
+			map[string]interface{}{"name": "projects/123/widgets/a", "size": 1},
+			map[string]interface{}{"name": "projects/123/widgets/b", "size": 2},
+			map[string]interface{}{"widgetId": "c"},
+		},
+	}
+
+	cases := map[string]struct {
+		idField string
+		id      string
+		want    map[string]interface{}
+	}{
+		"resource name suffix": {
+			idField: "name",
+			id:      "b",
+			want:    map[string]interface{}{"name": "projects/123/widgets/b", "size": 2},
+		},
+		"exact id": {
+			idField: "widgetId",
+			id:      "c",
+			want:    map[string]interface{}{"widgetId": "c"},
+		},
+		"missing entry": {
+			idField: "name",
+			id:      "d",
+		},
+		"partial segment": {
+			idField: "name",
+			id:      "gets/a",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := BatchResponseEntry(res, "widgets", tc.idField, tc.id)
+			if tc.want == nil {
+				if err == nil {
+					t.Errorf("expected error, got entry %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got unexpected error %s", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected entry %v, got %v", tc.want, got)
+			}
+		})
+	}
+
+	if _, err := BatchResponseEntry([]interface{}{}, "widgets", "name", "a"); err == nil {
+		t.Errorf("expected error reading response of wrong type")
+	}
+}
+
+// testListAppendBatchCreate creates widgets the way generated resources with
+// batching do: each request appends its entry to the batch, and reads its own
+// widget from the combined response. Widgets named in missing are left out of
+// the response.
+func testListAppendBatchCreate(t *testing.T, names []string, missing ...string) ([]map[string]interface{}, []error, int) {
+	testBatcher := NewRequestBatcher(
+		"testBatcher",
+		context.Background(),
+		&BatchingConfig{
+			SendAfter:      time.Duration(1) * time.Second,
+			EnableBatching: true,
+		})
+
+	var mu sync.Mutex
+	sent := 0
+	testSendBatch := func(name string, body interface{}) (interface{}, error) {
+		mu.Lock()
+		sent++
+		mu.Unlock()
+		var widgets []interface{}
+		for _, e := range body.(map[string]interface{})["requests"].([]interface{}) {
+			widgetId := e.(map[string]interface{})["widgetId"].(string)
+			if slices.Contains(missing, widgetId) {
+				continue
+			}
+			// Responses don't have to follow the order of the requests.
+			widgets = append([]interface{}{map[string]interface{}{"name": "projects/123/widgets/" + widgetId}}, widgets...)
+		}
+		return map[string]interface{}{"widgets": widgets}, nil
+	}
+
+	entries := make([]map[string]interface{}, len(names))
+	errs := make([]error, len(names))
+	wg := sync.WaitGroup{}
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			req := &BatchRequest{
+				DebugId:      fmt.Sprintf("Create widget %q", name),
+				ResourceName: "projects/p/widgets:batchCreate",
+				Body: map[string]interface{}{
+					"requests": []interface{}{map[string]interface{}{"widgetId": name}},
+				},
+				CombineF: CombineListAppendBatches("requests"),
+				SendF:    testSendBatch,
+			}
+			res, err := testBatcher.SendRequestWithTimeout("projects/p/widgets:batchCreate", req, time.Duration(6)*time.Second)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			entries[i], errs[i] = BatchResponseEntry(res, "widgets", "name", name)
+		}(i, name)
+	}
+	wg.Wait()
+	return entries, errs, sent
+}
+
+func TestRequestBatcher_listAppendBatchCreate(t *testing.T) {
+	names := []string{"a", "b", "c"}
+	entries, errs, sent := testListAppendBatchCreate(t, names)
+	if sent != 1 {
+		t.Errorf("expected creates to be sent in 1 batch, got %d", sent)
+	}
+	for i, name := range names {
+		if errs[i] != nil {
+			t.Fatalf("got unexpected error creating widget %q: %s", name, errs[i])
+		}
+		if want := "projects/123/widgets/" + name; entries[i]["name"] != want {
+			t.Errorf("expected widget %q, got %v", want, entries[i])
+		}
+	}
+}
+
+func TestRequestBatcher_listAppendBatchCreateMissingEntry(t *testing.T) {
+	_, errs, _ := testListAppendBatchCreate(t, []string{"a", "b"}, "b")
+	if errs[0] != nil {
+		t.Errorf("got unexpected error creating widget a: %s", errs[0])
+	}
+	if errs[1] == nil {
+		t.Errorf("expected error creating widget b missing from the batch response")
+	}
+}
@@ -209,6 +209,24 @@ func MetadataRetryWrapper(update func() error) error {
 	return fmt.Errorf("Failed to update metadata after %d retries", attempt)
 }
 
+// Retry the operation if the etag sent with it no longer matches the resource,
+// because it was changed since it was last read. Callers must re-read the etag
+// before retrying, so this predicate is never registered globally.
+func IsEtagConflictError(err error) (bool, string) {
+	gerr, ok := err.(*googleapi.Error)
+	if !ok {
+		return false, ""
+	}
+
+	if gerr.Code == 412 {
+		return true, "etag precondition failed"
+	}
+	if gerr.Code == 409 && strings.Contains(gerr.Body, "ABORTED") {
+		return true, "etag conflict - request aborted"
+	}
+	return false, ""
+}
+
 // If a permission necessary to provision a resource is created in the same config
 // as the resource itself, the permission may not have propagated by the time terraform
 // attempts to create the resource. This allows those errors to be retried until the timeout expires
@@ -204,6 +204,39 @@ func TestFirestoreIndex409_retryUnderlyingDataChanged(t *testing.T) {
 	}
 }
 
+func TestIsEtagConflictError_preconditionFailed(t *testing.T) {
+	err := googleapi.Error{
+		Code: 412,
+		Body: "Precondition Failed",
+	}
+	isRetryable, _ := IsEtagConflictError(&err)
+	if !isRetryable {
+		t.Errorf("Error not detected as retryable")
+	}
+}
+
+func TestIsEtagConflictError_aborted(t *testing.T) {
+	err := googleapi.Error{
+		Code: 409,
+		Body: `{"error": {"code": 409, "message": "The etag does not match the current etag.", "status": "ABORTED"}}`,
+	}
+	isRetryable, _ := IsEtagConflictError(&err)
+	if !isRetryable {
+		t.Errorf("Error not detected as retryable")
+	}
+}
+
+func TestIsEtagConflictError_alreadyExists(t *testing.T) {
+	err := googleapi.Error{
+		Code: 409,
+		Body: `{"error": {"code": 409, "message": "Resource already exists.", "status": "ALREADY_EXISTS"}}`,
+	}
+	isRetryable, _ := IsEtagConflictError(&err)
+	if isRetryable {
+		t.Errorf("Error incorrectly detected as retryable")
+	}
+}
+
 func TestExternalIpServiceNotActive(t *testing.T) {
 	err := googleapi.Error{
 		Code: 400,
@@ -0,0 +1,28 @@
+package ancestrymanager
+
+import (
+	"io"
+
+	caiexport "github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/ancestrymanager"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+
+	"go.uber.org/zap"
+)
+
+// ReadCaiExport builds ancestry cache entries, as accepted by New, from a Cloud
+// Asset Inventory export. The export can be either newline delimited JSON, as
+// written by `gcloud asset export`, or a JSON array of assets.
+func ReadCaiExport(r io.Reader) (map[string]string, error) {
+	return caiexport.ReadCaiExport(r)
+}
+
+// NewFromCaiExport returns AncestryManager like New, with the offline cache
+// built from the Cloud Asset Inventory export at exportFile. Entries take
+// precedence over the export.
+func NewFromCaiExport(cfg *transport_tpg.Config, offline bool, exportFile string, entries map[string]string, errorLogger *zap.Logger) (AncestryManager, error) {
+	merged, err := caiexport.ReadCaiExportFile(exportFile, entries)
+	if err != nil {
+		return nil, err
+	}
+	return New(cfg, offline, merged, errorLogger)
+}
@@ -0,0 +1,47 @@
+package ancestrymanager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+)
+
+func TestNewFromCaiExport(t *testing.T) {
+	exportFile := filepath.Join(t.TempDir(), "export.json")
+	export := `{"name":"//cloudresourcemanager.googleapis.com/projects/123","asset_type":"cloudresourcemanager.googleapis.com/Project","resource":{"data":{"projectId":"test-proj","projectNumber":"123"}},"ancestors":["projects/123","folders/456","organizations/789"]}
+{"name":"//cloudresourcemanager.googleapis.com/folders/456","asset_type":"cloudresourcemanager.googleapis.com/Folder","ancestors":["folders/456","organizations/789"]}
+{"name":"//cloudresourcemanager.googleapis.com/folders/555","asset_type":"cloudresourcemanager.googleapis.com/Folder","ancestors":["folders/555","organizations/789"]}
+`
+	if err := os.WriteFile(exportFile, []byte(export), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	entries := map[string]string{
+		"folders/555": "organizations/000",
+	}
+
+	am, err := NewFromCaiExport(nil, true, exportFile, entries, zap.NewExample())
+	if err != nil {
+		t.Fatalf("NewFromCaiExport(%s) = %s, want = nil", exportFile, err)
+	}
+	want := map[string][]string{
+		"projects/123":       {"projects/123", "folders/456", "organizations/789"},
+		"projects/test-proj": {"projects/123", "folders/456", "organizations/789"},
+		"folders/456":        {"folders/456", "organizations/789"},
+		"folders/555":        {"folders/555", "organizations/000"},
+		"organizations/789":  {"organizations/789"},
+		"organizations/000":  {"organizations/000"},
+	}
+	if diff := cmp.Diff(want, am.(*manager).ancestorCache); diff != "" {
+		t.Errorf("NewFromCaiExport(%s) returned unexpected cache diff (-want +got):\n%s", exportFile, diff)
+	}
+}
+
+func TestNewFromCaiExport_Fail(t *testing.T) {
+	exportFile := filepath.Join(t.TempDir(), "missing.json")
+	if _, err := NewFromCaiExport(nil, true, exportFile, nil, zap.NewExample()); err == nil {
+		t.Errorf("NewFromCaiExport(%s) = nil, want = error", exportFile)
+	}
+}
@@ -13,6 +13,14 @@ import (
 // require updating function signatures all along the pipe.
 type Options struct {
 	ErrorLogger *zap.Logger
+
+	// Whether to emit an import block for each converted resource, so that
+	// the output can be applied to bring the resources under management.
+	EmitImportBlocks bool
+
+	// Whether to replace attribute values referring to resources converted in
+	// the same batch with references to them.
+	ResolveReferences bool
 }
 
 // Converts CAI Assets into HCL string.
@@ -35,7 +43,20 @@ func Convert(assets []*caiasset.Asset, options *Options) ([]byte, error) {
 		}
 	}
 
-	t, err := models.HclWriteBlocks(allBlocks)
+	if options.EmitImportBlocks || options.ResolveReferences {
+		models.SetResourceAddresses(allBlocks)
+	}
+	if options.ResolveReferences {
+		allBlocks = converters.ResolveReferences(allBlocks)
+	}
+
+	var t []byte
+	var err error
+	if options.EmitImportBlocks {
+		t, err = models.HclWriteBlocksWithImports(allBlocks)
+	} else {
+		t, err = models.HclWriteBlocks(allBlocks)
+	}
 
 	options.ErrorLogger.Debug(string(t))
 
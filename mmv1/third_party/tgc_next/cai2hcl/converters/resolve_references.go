@@ -0,0 +1,173 @@
+package converters
+
+import (
+	"strconv"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/cai2hcl/models"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ResourceReference is an attribute referring to another resource.
+type ResourceReference struct {
+	// The Terraform resource type referred to, e.g. google_compute_network.
+	ResourceType string
+	// The attribute of the referred resource the value is read from, e.g.
+	// name or self_link. Defaults to id.
+	Attribute string
+}
+
+// ResolveReferences replaces attribute values referring to resources converted
+// in the same batch with Terraform references to the attribute they were read
+// from, such as google_compute_region_backend_service.x.self_link, and orders
+// the blocks so that referenced resources come before the resources referring
+// to them.
+//
+// Resources are matched by import ID or by the value of the referred
+// attribute, so only blocks with an import ID can be referenced. The
+// attributes that may refer to other resources are listed in
+// ResourceReferences.
+func ResolveReferences(blocks []*models.TerraformResourceBlock) []*models.TerraformResourceBlock {
+	blocksByImportId := make(map[string]map[string]*models.TerraformResourceBlock)
+	for _, block := range blocks {
+		if block.ImportId == "" || len(block.Labels) != 2 {
+			continue
+		}
+		if blocksByImportId[block.Labels[0]] == nil {
+			blocksByImportId[block.Labels[0]] = make(map[string]*models.TerraformResourceBlock)
+		}
+		blocksByImportId[block.Labels[0]][block.ImportId] = block
+	}
+
+	dependencies := make(map[*models.TerraformResourceBlock][]*models.TerraformResourceBlock)
+	for _, block := range blocks {
+		if len(block.Labels) != 2 {
+			continue
+		}
+		references := ResourceReferences[block.Labels[0]]
+		if len(references) == 0 {
+			continue
+		}
+		walkStringValues(block.Value, "", "", func(path, attribute, value string) {
+			reference, ok := references[attribute]
+			if !ok {
+				return
+			}
+			referencedAttribute := reference.Attribute
+			if referencedAttribute == "" {
+				referencedAttribute = "id"
+			}
+			referenced := findReferencedBlock(blocksByImportId[reference.ResourceType], referencedAttribute, value)
+			if referenced == nil || referenced == block {
+				return
+			}
+			if block.References == nil {
+				block.References = make(map[string]hcl.Traversal)
+			}
+			block.References[path] = hcl.Traversal{
+				hcl.TraverseRoot{Name: referenced.Labels[0]},
+				hcl.TraverseAttr{Name: referenced.Labels[1]},
+				hcl.TraverseAttr{Name: referencedAttribute},
+			}
+			dependencies[block] = append(dependencies[block], referenced)
+		})
+	}
+
+	return orderByDependencies(blocks, dependencies)
+}
+
+// walkStringValues calls fn for each string within val, with its path, e.g.
+// network_interface.0.subnetwork, and the path of its attribute without list
+// indices, e.g. network_interface.subnetwork. Map values are skipped, as they
+// are not attributes.
+func walkStringValues(val cty.Value, path, attribute string, fn func(path, attribute, value string)) {
+	if val.IsNull() || !val.IsKnown() {
+		return
+	}
+	valType := val.Type()
+	switch {
+	case valType == cty.String:
+		fn(path, attribute, val.AsString())
+	case valType.IsObjectType():
+		it := val.ElementIterator()
+		for it.Next() {
+			name, attr := it.Element()
+			walkStringValues(attr, joinPath(path, name.AsString()), joinPath(attribute, name.AsString()), fn)
+		}
+	case valType.IsListType() || valType.IsSetType() || valType.IsTupleType():
+		it := val.ElementIterator()
+		for i := 0; it.Next(); i++ {
+			_, elem := it.Element()
+			walkStringValues(elem, joinPath(path, strconv.Itoa(i)), attribute, fn)
+		}
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// findReferencedBlock returns the block whose import ID is value, or a suffix
+// of value following a slash, so that self links such as
+// https://www.googleapis.com/compute/v1/projects/p/global/networks/n match the
+// import ID projects/p/global/networks/n. Otherwise, it returns the only block
+// whose attribute is value, such as a resource referred to by name.
+func findReferencedBlock(blocksByImportId map[string]*models.TerraformResourceBlock, attribute, value string) *models.TerraformResourceBlock {
+	if len(blocksByImportId) == 0 || value == "" {
+		return nil
+	}
+	if block, ok := blocksByImportId[value]; ok {
+		return block
+	}
+	for i, c := range value {
+		if c != '/' {
+			continue
+		}
+		if block, ok := blocksByImportId[value[i+1:]]; ok {
+			return block
+		}
+	}
+
+	// Names may be shared by resources in different projects or locations, so
+	// a value matching several blocks is left as is.
+	var found *models.TerraformResourceBlock
+	for _, block := range blocksByImportId {
+		if !block.Value.Type().IsObjectType() || !block.Value.Type().HasAttribute(attribute) {
+			continue
+		}
+		attr := block.Value.GetAttr(attribute)
+		if attr.IsNull() || !attr.IsKnown() || attr.Type() != cty.String || attr.AsString() != value {
+			continue
+		}
+		if found != nil {
+			return nil
+		}
+		found = block
+	}
+	return found
+}
+
+// orderByDependencies orders the blocks so that each block comes after the
+// blocks it depends on, keeping the original order otherwise.
+func orderByDependencies(blocks []*models.TerraformResourceBlock, dependencies map[*models.TerraformResourceBlock][]*models.TerraformResourceBlock) []*models.TerraformResourceBlock {
+	ordered := make([]*models.TerraformResourceBlock, 0, len(blocks))
+	visited := make(map[*models.TerraformResourceBlock]bool)
+	var visit func(block *models.TerraformResourceBlock)
+	visit = func(block *models.TerraformResourceBlock) {
+		if visited[block] {
+			return
+		}
+		visited[block] = true
+		for _, dependency := range dependencies[block] {
+			visit(dependency)
+		}
+		ordered = append(ordered, block)
+	}
+	for _, block := range blocks {
+		visit(block)
+	}
+	return ordered
+}
@@ -0,0 +1,97 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/cai2hcl/models"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/stretchr/testify/assert"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestResolveReferences(t *testing.T) {
+	instance := &models.TerraformResourceBlock{
+		Labels:   []string{"google_compute_instance", "instance"},
+		ImportId: "projects/my-project/zones/us-central1-a/instances/instance",
+		Value: cty.ObjectVal(map[string]cty.Value{
+			"network_interface": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"network":    cty.StringVal("https://www.googleapis.com/compute/v1/projects/my-project/global/networks/network"),
+					"subnetwork": cty.StringVal("https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/subnetworks/other"),
+				}),
+			}),
+		}),
+	}
+	network := &models.TerraformResourceBlock{
+		Labels:   []string{"google_compute_network", "network"},
+		ImportId: "projects/my-project/global/networks/network",
+		Value:    cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("network")}),
+	}
+
+	blocks := ResolveReferences([]*models.TerraformResourceBlock{instance, network})
+
+	assert.Equal(t, []*models.TerraformResourceBlock{network, instance}, blocks)
+
+	got, err := models.HclWriteBlocks(blocks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `resource "google_compute_network" "network" {
+  name = "network"
+}
+
+resource "google_compute_instance" "instance" {
+  network_interface {
+    network    = google_compute_network.network.self_link
+    subnetwork = "https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/subnetworks/other"
+  }
+}
+`, string(got))
+}
+
+func TestResolveReferencesImportingName(t *testing.T) {
+	ResourceReferences["google_test_subscription"] = map[string]ResourceReference{
+		"topic": {ResourceType: "google_test_topic", Attribute: "name"},
+	}
+	t.Cleanup(func() { delete(ResourceReferences, "google_test_subscription") })
+
+	subscription := &models.TerraformResourceBlock{
+		Labels:   []string{"google_test_subscription", "subscription"},
+		ImportId: "projects/my-project/subscriptions/subscription",
+		Value:    cty.ObjectVal(map[string]cty.Value{"topic": cty.StringVal("topic")}),
+	}
+	topic := &models.TerraformResourceBlock{
+		Labels:   []string{"google_test_topic", "topic"},
+		ImportId: "projects/my-project/topics/topic",
+		Value:    cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("topic")}),
+	}
+	// Topics sharing a name in another project are not referred to by name.
+	ambiguous := &models.TerraformResourceBlock{
+		Labels:   []string{"google_test_subscription", "ambiguous"},
+		ImportId: "projects/my-project/subscriptions/ambiguous",
+		Value:    cty.ObjectVal(map[string]cty.Value{"topic": cty.StringVal("shared")}),
+	}
+	shared := []*models.TerraformResourceBlock{
+		{
+			Labels:   []string{"google_test_topic", "shared"},
+			ImportId: "projects/my-project/topics/shared",
+			Value:    cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("shared")}),
+		},
+		{
+			Labels:   []string{"google_test_topic", "shared_2"},
+			ImportId: "projects/other-project/topics/shared",
+			Value:    cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("shared")}),
+		},
+	}
+
+	blocks := ResolveReferences(append([]*models.TerraformResourceBlock{subscription, topic, ambiguous}, shared...))
+
+	assert.Equal(t, []*models.TerraformResourceBlock{topic, subscription, ambiguous, shared[0], shared[1]}, blocks)
+	assert.Equal(t, "google_test_topic.topic.name", traversalString(subscription.References["topic"]))
+	assert.Empty(t, ambiguous.References)
+}
+
+func traversalString(traversal hcl.Traversal) string {
+	return string(hclwrite.TokensForTraversal(traversal).Bytes())
+}
@@ -116,8 +116,9 @@ func (c *ComputeInstanceConverter) convertResourceData(asset *caiasset.Asset) (*
 		return nil, err
 	}
 	return &models.TerraformResourceBlock{
-		Labels: []string{c.name, instance.Name},
-		Value:  ctyVal,
+		Labels:   []string{c.name, instance.Name},
+		Value:    ctyVal,
+		ImportId: strings.TrimPrefix(asset.Name, "//compute.googleapis.com/"),
 	}, nil
 
 }
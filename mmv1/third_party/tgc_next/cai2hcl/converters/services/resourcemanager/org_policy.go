@@ -0,0 +1,235 @@
+package resourcemanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/cai2hcl/converters/utils"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/cai2hcl/models"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+
+	tfschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// FolderAssetType is the CAI asset type name for folder.
+const FolderAssetType string = "cloudresourcemanager.googleapis.com/Folder"
+
+// OrganizationAssetType is the CAI asset type name for organization.
+const OrganizationAssetType string = "cloudresourcemanager.googleapis.com/Organization"
+
+// OrgPolicyPolicySchemaName is the TF resource schema name for organization
+// policies set with the v2 API.
+const OrgPolicyPolicySchemaName string = "google_org_policy_policy"
+
+const crmAssetNamePrefix = "//cloudresourcemanager.googleapis.com/"
+
+// OrgPolicyConverter for the organization policies set on a project, folder
+// or organization.
+type OrgPolicyConverter struct {
+	// TF resource schema name of the v1 policies, which depends on the
+	// resource the policies are set on
+	name     string
+	schema   map[string]*tfschema.Schema
+	v2Schema map[string]*tfschema.Schema
+}
+
+// NewFolderOrgPolicyConverter returns an HCL converter for the organization
+// policies of a folder.
+func NewFolderOrgPolicyConverter(provider *tfschema.Provider) models.Converter {
+	return newOrgPolicyConverter(provider, "google_folder_organization_policy")
+}
+
+// NewOrganizationOrgPolicyConverter returns an HCL converter for the
+// organization policies of an organization.
+func NewOrganizationOrgPolicyConverter(provider *tfschema.Provider) models.Converter {
+	return newOrgPolicyConverter(provider, "google_organization_policy")
+}
+
+func newOrgPolicyConverter(provider *tfschema.Provider, name string) *OrgPolicyConverter {
+	return &OrgPolicyConverter{
+		name:     name,
+		schema:   provider.ResourcesMap[name].Schema,
+		v2Schema: provider.ResourcesMap[OrgPolicyPolicySchemaName].Schema,
+	}
+}
+
+// Convert converts the organization policies of the asset.
+func (c *OrgPolicyConverter) Convert(asset *caiasset.Asset) ([]*models.TerraformResourceBlock, error) {
+	if asset == nil {
+		return nil, nil
+	}
+
+	parent := strings.TrimPrefix(asset.Name, crmAssetNamePrefix)
+	var blocks []*models.TerraformResourceBlock
+	for _, policy := range asset.OrgPolicy {
+		if policy == nil {
+			continue
+		}
+		block, err := c.convertOrgPolicy(parent, policy)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	for _, policy := range asset.V2OrgPolicies {
+		if policy == nil {
+			continue
+		}
+		block, err := c.convertV2OrgPolicy(parent, policy)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+func (c *OrgPolicyConverter) convertOrgPolicy(parent string, policy *caiasset.OrgPolicy) (*models.TerraformResourceBlock, error) {
+	constraint := strings.TrimPrefix(policy.Constraint, "constraints/")
+	hclData := map[string]interface{}{
+		"constraint": policy.Constraint,
+	}
+
+	var importId string
+	switch {
+	case strings.HasPrefix(parent, "projects/"):
+		project := strings.TrimPrefix(parent, "projects/")
+		hclData["project"] = project
+		importId = fmt.Sprintf("%s:%s", project, policy.Constraint)
+	case strings.HasPrefix(parent, "folders/"):
+		hclData["folder"] = parent
+		importId = fmt.Sprintf("%s/%s", parent, policy.Constraint)
+	case strings.HasPrefix(parent, "organizations/"):
+		orgID := strings.TrimPrefix(parent, "organizations/")
+		hclData["org_id"] = orgID
+		importId = fmt.Sprintf("%s/%s", orgID, policy.Constraint)
+	default:
+		return nil, fmt.Errorf("invalid parent %s of organization policy %s", parent, policy.Constraint)
+	}
+
+	if policy.BooleanPolicy != nil {
+		hclData["boolean_policy"] = []interface{}{
+			map[string]interface{}{"enforced": policy.BooleanPolicy.Enforced},
+		}
+	}
+	if policy.ListPolicy != nil {
+		hclData["list_policy"] = flattenListPolicy(policy.ListPolicy)
+	}
+	if policy.RestoreDefault != nil {
+		hclData["restore_policy"] = []interface{}{
+			map[string]interface{}{"default": true},
+		}
+	}
+
+	ctyVal, err := utils.MapToCtyValWithSchema(hclData, c.schema)
+	if err != nil {
+		return nil, err
+	}
+	return &models.TerraformResourceBlock{
+		Labels:   []string{c.name, orgPolicyBlockName(parent, constraint)},
+		Value:    ctyVal,
+		ImportId: importId,
+	}, nil
+}
+
+func flattenListPolicy(listPolicy *caiasset.ListPolicy) []interface{} {
+	result := map[string]interface{}{
+		"suggested_value":     listPolicy.SuggestedValue,
+		"inherit_from_parent": listPolicy.InheritFromParent,
+	}
+
+	switch {
+	case listPolicy.AllValues == caiasset.ListPolicyAllValuesAllow:
+		result["allow"] = []interface{}{map[string]interface{}{"all": true}}
+	case listPolicy.AllValues == caiasset.ListPolicyAllValuesDeny:
+		result["deny"] = []interface{}{map[string]interface{}{"all": true}}
+	case len(listPolicy.AllowedValues) > 0:
+		result["allow"] = []interface{}{map[string]interface{}{"values": listPolicy.AllowedValues}}
+	case len(listPolicy.DeniedValues) > 0:
+		result["deny"] = []interface{}{map[string]interface{}{"values": listPolicy.DeniedValues}}
+	}
+	return []interface{}{result}
+}
+
+func (c *OrgPolicyConverter) convertV2OrgPolicy(parent string, policy *caiasset.V2OrgPolicies) (*models.TerraformResourceBlock, error) {
+	hclData := map[string]interface{}{
+		"name":   policy.Name,
+		"parent": parent,
+	}
+	if policy.PolicySpec != nil {
+		hclData["spec"] = flattenPolicySpec(policy.PolicySpec)
+	}
+
+	ctyVal, err := utils.MapToCtyValWithSchema(hclData, c.v2Schema)
+	if err != nil {
+		return nil, err
+	}
+	constraint := policy.Name[strings.LastIndex(policy.Name, "/")+1:]
+	return &models.TerraformResourceBlock{
+		Labels:   []string{OrgPolicyPolicySchemaName, orgPolicyBlockName(parent, constraint)},
+		Value:    ctyVal,
+		ImportId: policy.Name,
+	}, nil
+}
+
+func flattenPolicySpec(spec *caiasset.PolicySpec) []interface{} {
+	var rules []interface{}
+	for _, rule := range spec.PolicyRules {
+		if rule == nil {
+			continue
+		}
+		r := map[string]interface{}{
+			"allow_all": flattenPolicyRuleBool(rule.AllowAll),
+			"deny_all":  flattenPolicyRuleBool(rule.DenyAll),
+			"enforce":   flattenPolicyRuleBool(rule.Enforce),
+		}
+		if rule.Values != nil {
+			r["values"] = []interface{}{
+				map[string]interface{}{
+					"allowed_values": rule.Values.AllowedValues,
+					"denied_values":  rule.Values.DeniedValues,
+				},
+			}
+		}
+		if rule.Condition != nil {
+			r["condition"] = []interface{}{
+				map[string]interface{}{
+					"expression":  rule.Condition.Expression,
+					"title":       rule.Condition.Title,
+					"description": rule.Condition.Description,
+					"location":    rule.Condition.Location,
+				},
+			}
+		}
+		rules = append(rules, r)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"inherit_from_parent": spec.InheritFromParent,
+			"reset":               spec.Reset,
+			"rules":               rules,
+		},
+	}
+}
+
+// flattenPolicyRuleBool flattens the boolean fields of policy rules, which are
+// strings in the TF schema. Unset fields are left empty.
+func flattenPolicyRuleBool(v bool) interface{} {
+	if v {
+		return "TRUE"
+	}
+	return nil
+}
+
+// orgPolicyBlockName names the block of the organization policy of the parent,
+// like my-project_compute_skipDefaultNetworkCreation. Folder and organization
+// IDs are numeric and block names can't start with a digit, so their names are
+// prefixed with the kind of parent, like folder_123_compute_skipDefaultNetworkCreation.
+func orgPolicyBlockName(parent, constraint string) string {
+	collection, id, _ := strings.Cut(parent, "/")
+	if collection != "projects" {
+		id = strings.TrimSuffix(collection, "s") + "_" + id
+	}
+	return id + "_" + strings.ReplaceAll(constraint, ".", "_")
+}
@@ -0,0 +1,236 @@
+package resourcemanager
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/cai2hcl/models"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/hashicorp/terraform-provider-google-beta/google-beta/provider"
+)
+
+func TestOrgPolicyConverter(t *testing.T) {
+	p := provider.Provider()
+	cases := []struct {
+		name      string
+		converter models.Converter
+		asset     *caiasset.Asset
+		want      string
+	}{
+		{
+			name:      "project policies",
+			converter: NewProjectConverter(p),
+			asset: &caiasset.Asset{
+				Name: "//cloudresourcemanager.googleapis.com/projects/my-project",
+				Type: ProjectAssetType,
+				OrgPolicy: []*caiasset.OrgPolicy{
+					{
+						Constraint:    "constraints/compute.skipDefaultNetworkCreation",
+						BooleanPolicy: &caiasset.BooleanPolicy{Enforced: true},
+					},
+				},
+				V2OrgPolicies: []*caiasset.V2OrgPolicies{
+					{
+						Name: "projects/my-project/policies/gcp.resourceLocations",
+						PolicySpec: &caiasset.PolicySpec{
+							PolicyRules: []*caiasset.PolicyRule{
+								{
+									Values: &caiasset.StringValues{
+										AllowedValues: []string{"in:us-locations"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: `resource "google_project_organization_policy" "my-project_compute_skipDefaultNetworkCreation" {
+  boolean_policy {
+    enforced = true
+  }
+
+  constraint = "constraints/compute.skipDefaultNetworkCreation"
+  project    = "my-project"
+}
+
+resource "google_org_policy_policy" "my-project_gcp_resourceLocations" {
+  name   = "projects/my-project/policies/gcp.resourceLocations"
+  parent = "projects/my-project"
+
+  spec {
+    inherit_from_parent = false
+    reset               = false
+
+    rules {
+      values {
+        allowed_values = ["in:us-locations"]
+      }
+    }
+  }
+}
+
+import {
+  to = google_project_organization_policy.my-project_compute_skipDefaultNetworkCreation
+  id = "my-project:constraints/compute.skipDefaultNetworkCreation"
+}
+
+import {
+  to = google_org_policy_policy.my-project_gcp_resourceLocations
+  id = "projects/my-project/policies/gcp.resourceLocations"
+}
+`,
+		},
+		{
+			name:      "folder list policies",
+			converter: NewFolderOrgPolicyConverter(p),
+			asset: &caiasset.Asset{
+				Name: "//cloudresourcemanager.googleapis.com/folders/123456",
+				Type: FolderAssetType,
+				OrgPolicy: []*caiasset.OrgPolicy{
+					{
+						Constraint: "constraints/serviceuser.services",
+						ListPolicy: &caiasset.ListPolicy{
+							AllValues: caiasset.ListPolicyAllValuesAllow,
+						},
+					},
+					{
+						Constraint: "constraints/compute.vmExternalIpAccess",
+						ListPolicy: &caiasset.ListPolicy{
+							DeniedValues:      []string{"projects/my-project/zones/us-central1-a/instances/my-instance"},
+							InheritFromParent: true,
+						},
+					},
+				},
+			},
+			want: `resource "google_folder_organization_policy" "folder_123456_serviceuser_services" {
+  constraint = "constraints/serviceuser.services"
+  folder     = "folders/123456"
+
+  list_policy {
+    allow {
+      all = true
+    }
+
+    inherit_from_parent = false
+  }
+}
+
+resource "google_folder_organization_policy" "folder_123456_compute_vmExternalIpAccess" {
+  constraint = "constraints/compute.vmExternalIpAccess"
+  folder     = "folders/123456"
+
+  list_policy {
+    deny {
+      values = ["projects/my-project/zones/us-central1-a/instances/my-instance"]
+    }
+
+    inherit_from_parent = true
+  }
+}
+
+import {
+  to = google_folder_organization_policy.folder_123456_serviceuser_services
+  id = "folders/123456/constraints/serviceuser.services"
+}
+
+import {
+  to = google_folder_organization_policy.folder_123456_compute_vmExternalIpAccess
+  id = "folders/123456/constraints/compute.vmExternalIpAccess"
+}
+`,
+		},
+		{
+			name:      "organization policies",
+			converter: NewOrganizationOrgPolicyConverter(p),
+			asset: &caiasset.Asset{
+				Name: "//cloudresourcemanager.googleapis.com/organizations/654321",
+				Type: OrganizationAssetType,
+				OrgPolicy: []*caiasset.OrgPolicy{
+					{
+						Constraint:     "constraints/iam.disableServiceAccountKeyCreation",
+						RestoreDefault: &caiasset.RestoreDefault{},
+					},
+				},
+				V2OrgPolicies: []*caiasset.V2OrgPolicies{
+					{
+						Name: "organizations/654321/policies/compute.requireOsLogin",
+						PolicySpec: &caiasset.PolicySpec{
+							PolicyRules: []*caiasset.PolicyRule{
+								{
+									Enforce: true,
+									Condition: &caiasset.Expr{
+										Expression: "resource.matchTag('123/env', 'prod')",
+										Title:      "prod",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: `resource "google_organization_policy" "organization_654321_iam_disableServiceAccountKeyCreation" {
+  constraint = "constraints/iam.disableServiceAccountKeyCreation"
+  org_id     = "654321"
+
+  restore_policy {
+    default = true
+  }
+}
+
+resource "google_org_policy_policy" "organization_654321_compute_requireOsLogin" {
+  name   = "organizations/654321/policies/compute.requireOsLogin"
+  parent = "organizations/654321"
+
+  spec {
+    inherit_from_parent = false
+    reset               = false
+
+    rules {
+      condition {
+        expression = "resource.matchTag('123/env', 'prod')"
+        title      = "prod"
+      }
+
+      enforce = "TRUE"
+    }
+  }
+}
+
+import {
+  to = google_organization_policy.organization_654321_iam_disableServiceAccountKeyCreation
+  id = "654321/constraints/iam.disableServiceAccountKeyCreation"
+}
+
+import {
+  to = google_org_policy_policy.organization_654321_compute_requireOsLogin
+  id = "organizations/654321/policies/compute.requireOsLogin"
+}
+`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			blocks, err := c.converter.Convert(c.asset)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := models.HclWriteBlocksWithImports(blocks)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, c.want, string(got))
+		})
+	}
+}
+
+func TestOrgPolicyConverter_invalidParent(t *testing.T) {
+	converter := NewFolderOrgPolicyConverter(provider.Provider())
+	_, err := converter.Convert(&caiasset.Asset{
+		Name: "//cloudresourcemanager.googleapis.com/billingAccounts/123",
+		OrgPolicy: []*caiasset.OrgPolicy{
+			{Constraint: "constraints/compute.skipDefaultNetworkCreation"},
+		},
+	})
+	assert.ErrorContains(t, err, "invalid parent billingAccounts/123")
+}
@@ -21,6 +21,9 @@ const ProjectSchemaName string = "google_project"
 type ProjectConverter struct {
 	name   string
 	schema map[string]*tfschema.Schema
+
+	// Converter of the organization policies set on the project
+	orgPolicies *OrgPolicyConverter
 }
 
 // NewProjectConverter returns an HCL converter for compute project.
@@ -28,24 +31,33 @@ func NewProjectConverter(provider *tfschema.Provider) models.Converter {
 	schema := provider.ResourcesMap[ProjectSchemaName].Schema
 
 	return &ProjectConverter{
-		name:   ProjectSchemaName,
-		schema: schema,
+		name:        ProjectSchemaName,
+		schema:      schema,
+		orgPolicies: newOrgPolicyConverter(provider, "google_project_organization_policy"),
 	}
 }
 
 // Convert converts asset resource data.
 func (c *ProjectConverter) Convert(asset *caiasset.Asset) ([]*models.TerraformResourceBlock, error) {
-	if asset == nil || asset.Resource == nil && asset.Resource.Data == nil {
+	if asset == nil {
 		return nil, nil
 	}
 
 	var blocks []*models.TerraformResourceBlock
-	block, err := c.convertResourceData(asset)
+	// Assets of organization policies set on the project have no resource data.
+	if asset.Resource != nil && asset.Resource.Data != nil {
+		block, err := c.convertResourceData(asset)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+
+	policyBlocks, err := c.orgPolicies.Convert(asset)
 	if err != nil {
 		return nil, err
 	}
-	blocks = append(blocks, block)
-	return blocks, nil
+	return append(blocks, policyBlocks...), nil
 }
 
 func (c *ProjectConverter) convertResourceData(asset *caiasset.Asset) (*models.TerraformResourceBlock, error) {
@@ -70,7 +82,8 @@ func (c *ProjectConverter) convertResourceData(asset *caiasset.Asset) (*models.T
 		return nil, err
 	}
 	return &models.TerraformResourceBlock{
-		Labels: []string{c.name, assetResourceData["projectId"].(string)},
-		Value:  ctyVal,
+		Labels:   []string{c.name, assetResourceData["projectId"].(string)},
+		Value:    ctyVal,
+		ImportId: assetResourceData["projectId"].(string),
 	}, nil
 }
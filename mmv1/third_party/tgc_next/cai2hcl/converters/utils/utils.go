@@ -3,6 +3,7 @@ package utils
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 
 	hashicorpcty "github.com/hashicorp/go-cty/cty"
@@ -23,6 +24,75 @@ func ParseFieldValue(url string, name string) string {
 	return ""
 }
 
+var urlParamRegex = regexp.MustCompile(`{{(%?)(\w+)}}`)
+
+// ParseUrlParamValuesFromAssetName fills the fields missing from hclData, such as
+// project or location, with the values matching the url parameters of the asset
+// name template, e.g. //compute.googleapis.com/projects/{{project}}/global/addresses/{{name}}.
+// Asset names that don't match the template are left alone.
+func ParseUrlParamValuesFromAssetName(assetName, template string, hclData map[string]interface{}) error {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	last := 0
+	seen := make(map[string]bool)
+	for _, m := range urlParamRegex.FindAllStringSubmatchIndex(template, -1) {
+		name := template[m[4]:m[5]]
+		// Only the last value of a repeated parameter would be kept.
+		if seen[name] {
+			return fmt.Errorf("invalid asset name template %q: parameter %s is repeated", template, name)
+		}
+		seen[name] = true
+		pattern.WriteString(regexp.QuoteMeta(template[last:m[0]]))
+		// Parameters prefixed with % may contain slashes, as do parents such
+		// as projects/{{project}}/locations/{{location}}.
+		if m[3] > m[2] || name == "parent" {
+			fmt.Fprintf(&pattern, "(?P<%s>.+)", name)
+		} else {
+			fmt.Fprintf(&pattern, "(?P<%s>[^/]+)", name)
+		}
+		last = m[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(template[last:]))
+	pattern.WriteString("$")
+
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return fmt.Errorf("invalid asset name template %q: %w", template, err)
+	}
+	match := re.FindStringSubmatch(assetName)
+	if match == nil {
+		return nil
+	}
+	for i, name := range re.SubexpNames() {
+		if name == "" {
+			continue
+		}
+		if v, ok := hclData[name]; ok && v != nil {
+			continue
+		}
+		hclData[name] = match[i]
+	}
+	return nil
+}
+
+// ImportIdFromTemplate builds the ID a resource is imported with by replacing
+// the parameters of one of its import formats with their values in hclData.
+// Returns an empty string if any of the parameters has no value.
+func ImportIdFromTemplate(template string, hclData map[string]interface{}) string {
+	missing := false
+	id := urlParamRegex.ReplaceAllStringFunc(template, func(param string) string {
+		value, _ := hclData[urlParamRegex.FindStringSubmatch(param)[2]].(string)
+		if value == "" {
+			missing = true
+		}
+		return value
+	})
+	if missing {
+		return ""
+	}
+	return id
+}
+
 // Remove the Terraform attribution label "goog-terraform-provisioned" from labels
 func RemoveTerraformAttributionLabel(raw interface{}) interface{} {
 	if raw == nil {
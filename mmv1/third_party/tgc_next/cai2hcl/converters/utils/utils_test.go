@@ -185,3 +185,89 @@ func createSchema(name string) map[string]*schema.Schema {
 
 	return provider.ResourcesMap[name].Schema
 }
+
+func TestParseUrlParamValuesFromAssetName(t *testing.T) {
+	hclData := map[string]interface{}{
+		"name":        "address-1",
+		"description": nil,
+	}
+
+	err := ParseUrlParamValuesFromAssetName(
+		"//compute.googleapis.com/projects/my-project/regions/us-central1/addresses/address-1",
+		"//compute.googleapis.com/projects/{{project}}/regions/{{region}}/addresses/{{name}}",
+		hclData,
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":        "address-1",
+		"description": nil,
+		"project":     "my-project",
+		"region":      "us-central1",
+	}, hclData)
+}
+
+func TestParseUrlParamValuesFromAssetNameWithSlashes(t *testing.T) {
+	hclData := map[string]interface{}{
+		"name": nil,
+	}
+
+	err := ParseUrlParamValuesFromAssetName(
+		"//pubsub.googleapis.com/projects/my-project/schemas/my-schema",
+		"//pubsub.googleapis.com/{{%parent}}/schemas/{{name}}",
+		hclData,
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":   "my-schema",
+		"parent": "projects/my-project",
+	}, hclData)
+}
+
+func TestParseUrlParamValuesFromAssetNameMismatch(t *testing.T) {
+	hclData := map[string]interface{}{}
+
+	err := ParseUrlParamValuesFromAssetName(
+		"//compute.googleapis.com/projects/my-project/global/addresses/address-1",
+		"//compute.googleapis.com/projects/{{project}}/regions/{{region}}/addresses/{{name}}",
+		hclData,
+	)
+
+	assert.NoError(t, err)
+	assert.Empty(t, hclData)
+}
+
+func TestParseUrlParamValuesFromAssetNameRepeatedParameter(t *testing.T) {
+	hclData := map[string]interface{}{}
+
+	err := ParseUrlParamValuesFromAssetName(
+		"//example.googleapis.com/projects/my-project/things/my-project",
+		"//example.googleapis.com/projects/{{project}}/things/{{project}}",
+		hclData,
+	)
+
+	assert.ErrorContains(t, err, "invalid asset name template")
+	assert.Empty(t, hclData)
+}
+
+func TestImportIdFromTemplate(t *testing.T) {
+	hclData := map[string]interface{}{
+		"name":    "address-1",
+		"project": "my-project",
+		"region":  "us-central1",
+	}
+
+	assert.Equal(t,
+		"projects/my-project/regions/us-central1/addresses/address-1",
+		ImportIdFromTemplate("projects/{{project}}/regions/{{region}}/addresses/{{%name}}", hclData))
+}
+
+func TestImportIdFromTemplateMissingValue(t *testing.T) {
+	hclData := map[string]interface{}{
+		"name":   "address-1",
+		"region": nil,
+	}
+
+	assert.Empty(t, ImportIdFromTemplate("projects/{{project}}/regions/{{region}}/addresses/{{name}}", hclData))
+}
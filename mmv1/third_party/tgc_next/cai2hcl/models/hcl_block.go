@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/hcl/hcl/printer"
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/zclconf/go-cty/cty"
 )
@@ -12,23 +13,83 @@ import (
 type TerraformResourceBlock struct {
 	Labels []string
 	Value  cty.Value
+	// ID the resource is imported with, if known.
+	ImportId string
+	// References to other resources written in place of attribute values,
+	// keyed by attribute path, e.g. network_interface.0.subnetwork
+	References map[string]hcl.Traversal
 }
 
 func HclWriteBlocks(blocks []*TerraformResourceBlock) ([]byte, error) {
+	return hclWriteBlocks(blocks, false)
+}
+
+// HclWriteBlocksWithImports prints TerraformResourceBlock objects as string, followed
+// by an import block for each resource with a known import ID.
+func HclWriteBlocksWithImports(blocks []*TerraformResourceBlock) ([]byte, error) {
+	return hclWriteBlocks(blocks, true)
+}
+
+func hclWriteBlocks(blocks []*TerraformResourceBlock, withImports bool) ([]byte, error) {
 	f := hclwrite.NewFile()
 	rootBody := f.Body()
 
+	// References to other resources cannot be parsed by the HCL1 printer, so
+	// blocks with references are formatted by hclwrite instead.
+	hasReferences := false
 	for _, resourceBlock := range blocks {
+		if len(resourceBlock.References) > 0 {
+			hasReferences = true
+		}
+	}
+
+	for i, resourceBlock := range blocks {
+		if hasReferences && i > 0 {
+			rootBody.AppendNewline()
+		}
 		hclBlock := rootBody.AppendNewBlock("resource", resourceBlock.Labels)
-		if err := hclWriteBlock(resourceBlock.Value, hclBlock.Body()); err != nil {
+		if err := hclWriteBlock(resourceBlock.Value, hclBlock.Body(), "", resourceBlock.References); err != nil {
 			return nil, err
 		}
 	}
 
-	return printer.Format(f.Bytes())
+	var t []byte
+	if hasReferences {
+		t = hclwrite.Format(f.Bytes())
+	} else {
+		var err error
+		if t, err = printer.Format(f.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	if !withImports {
+		return t, nil
+	}
+
+	// Import blocks reference resources by address, which the HCL1 printer
+	// cannot parse, so they are formatted separately.
+	importFile := hclwrite.NewFile()
+	for _, resourceBlock := range blocks {
+		if resourceBlock.ImportId == "" || len(resourceBlock.Labels) != 2 {
+			continue
+		}
+		if len(importFile.Body().Blocks()) > 0 {
+			importFile.Body().AppendNewline()
+		}
+		importBlock := importFile.Body().AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: resourceBlock.Labels[0]},
+			hcl.TraverseAttr{Name: resourceBlock.Labels[1]},
+		})
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(resourceBlock.ImportId))
+	}
+	if len(importFile.Body().Blocks()) == 0 {
+		return t, nil
+	}
+	return append(append(t, '\n'), hclwrite.Format(importFile.Bytes())...), nil
 }
 
-func hclWriteBlock(val cty.Value, body *hclwrite.Body) error {
+func hclWriteBlock(val cty.Value, body *hclwrite.Body, path string, references map[string]hcl.Traversal) error {
 	if val.IsNull() {
 		return nil
 	}
@@ -41,11 +102,15 @@ func hclWriteBlock(val cty.Value, body *hclwrite.Body) error {
 		if objVal.IsNull() {
 			continue
 		}
+		objPath := objKey.AsString()
+		if path != "" {
+			objPath = path + "." + objPath
+		}
 		objValType := objVal.Type()
 		switch {
 		case objValType.IsObjectType():
 			newBlock := body.AppendNewBlock(objKey.AsString(), nil)
-			if err := hclWriteBlock(objVal, newBlock.Body()); err != nil {
+			if err := hclWriteBlock(objVal, newBlock.Body(), objPath, references); err != nil {
 				return err
 			}
 		case objValType.IsCollectionType():
@@ -55,17 +120,27 @@ func hclWriteBlock(val cty.Value, body *hclwrite.Body) error {
 			// Presumes map should not contain object type.
 			if !objValType.IsMapType() && objValType.ElementType().IsObjectType() {
 				listIterator := objVal.ElementIterator()
-				for listIterator.Next() {
+				for i := 0; listIterator.Next(); i++ {
 					_, listVal := listIterator.Element()
 					subBlock := body.AppendNewBlock(objKey.AsString(), nil)
-					if err := hclWriteBlock(listVal, subBlock.Body()); err != nil {
+					if err := hclWriteBlock(listVal, subBlock.Body(), fmt.Sprintf("%s.%d", objPath, i), references); err != nil {
 						return err
 					}
 				}
 				continue
 			}
+			if !objValType.IsMapType() {
+				if tokens, ok := tokensForCollectionWithReferences(objVal, objPath, references); ok {
+					body.SetAttributeRaw(objKey.AsString(), tokens)
+					continue
+				}
+			}
 			fallthrough
 		default:
+			if traversal, ok := references[objPath]; ok {
+				body.SetAttributeTraversal(objKey.AsString(), traversal)
+				continue
+			}
 			if objValType.FriendlyName() == "string" && objVal.AsString() == "" {
 				continue
 			}
@@ -74,3 +149,21 @@ func hclWriteBlock(val cty.Value, body *hclwrite.Body) error {
 	}
 	return nil
 }
+
+// tokensForCollectionWithReferences returns the tokens for a list or set with
+// elements replaced by references, or false if no element is a reference.
+func tokensForCollectionWithReferences(val cty.Value, path string, references map[string]hcl.Traversal) (hclwrite.Tokens, bool) {
+	var elems []hclwrite.Tokens
+	hasReferences := false
+	it := val.ElementIterator()
+	for i := 0; it.Next(); i++ {
+		_, elem := it.Element()
+		if traversal, ok := references[fmt.Sprintf("%s.%d", path, i)]; ok {
+			elems = append(elems, hclwrite.TokensForTraversal(traversal))
+			hasReferences = true
+		} else {
+			elems = append(elems, hclwrite.TokensForValue(elem))
+		}
+	}
+	return hclwrite.TokensForTuple(elems), hasReferences
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestHclWriteBlocksWithImports(t *testing.T) {
+	blocks := []*TerraformResourceBlock{
+		{
+			Labels:   []string{"google_compute_address", "address.1"},
+			Value:    cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("address.1")}),
+			ImportId: "projects/my-project/regions/us-central1/addresses/address.1",
+		},
+		{
+			Labels:   []string{"google_compute_address", "address_1"},
+			Value:    cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("address_1")}),
+			ImportId: "projects/my-project/regions/us-east1/addresses/address_1",
+		},
+		{
+			Labels: []string{"google_project", "123-project"},
+			Value:  cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("project")}),
+		},
+	}
+
+	SetResourceAddresses(blocks)
+	got, err := HclWriteBlocksWithImports(blocks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, `resource "google_compute_address" "address_1" {
+  name = "address.1"
+}
+
+resource "google_compute_address" "address_1_2" {
+  name = "address_1"
+}
+
+resource "google_project" "_123-project" {
+  name = "project"
+}
+
+import {
+  to = google_compute_address.address_1
+  id = "projects/my-project/regions/us-central1/addresses/address.1"
+}
+
+import {
+  to = google_compute_address.address_1_2
+  id = "projects/my-project/regions/us-east1/addresses/address_1"
+}
+`, string(got))
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var invalidResourceNameCharRegex = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// resourceName turns name into a valid Terraform resource name, which starts
+// with a letter or underscore and only contains letters, digits, underscores
+// and dashes.
+func resourceName(name string) string {
+	name = invalidResourceNameCharRegex.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "_" + name
+	}
+	return name
+}
+
+// SetResourceAddresses renames the blocks so that each has a valid and unique
+// resource address. Blocks are named in order, and a name already taken by an
+// earlier block of the same type is suffixed with _2, _3 and so on, so the
+// same blocks are always given the same addresses.
+func SetResourceAddresses(blocks []*TerraformResourceBlock) {
+	taken := make(map[string]bool)
+	for _, block := range blocks {
+		if len(block.Labels) != 2 {
+			continue
+		}
+		name := resourceName(block.Labels[1])
+		address := fmt.Sprintf("%s.%s", block.Labels[0], name)
+		for i := 2; taken[address]; i++ {
+			address = fmt.Sprintf("%s.%s_%d", block.Labels[0], name, i)
+		}
+		taken[address] = true
+		block.Labels[1] = address[len(block.Labels[0])+1:]
+	}
+}
@@ -109,6 +109,12 @@ func (t *Timestamp) UnmarshalJSON(b []byte) error {
 // `denied_values`.
 type ListPolicyAllValues int32
 
+const (
+	ListPolicyAllValuesUnspecified ListPolicyAllValues = 0
+	ListPolicyAllValuesAllow       ListPolicyAllValues = 1
+	ListPolicyAllValuesDeny        ListPolicyAllValues = 2
+)
+
 // ListPolicy can define specific values and subtrees of Cloud Resource
 // Manager resource hierarchy (`Organizations`, `Folders`, `Projects`) that
 // are allowed or denied by setting the `allowed_values` and `denied_values`
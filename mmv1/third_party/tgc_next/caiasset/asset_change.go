@@ -0,0 +1,27 @@
+package caiasset
+
+// ChangeType is the type of a planned change of an asset.
+type ChangeType string
+
+const (
+	ChangeTypeCreate  ChangeType = "CREATE"
+	ChangeTypeUpdate  ChangeType = "UPDATE"
+	ChangeTypeDelete  ChangeType = "DELETE"
+	ChangeTypeReplace ChangeType = "REPLACE"
+)
+
+// AssetChange is a planned change of an asset, with the state of the asset
+// before and after the change.
+type AssetChange struct {
+	// The name of the changed asset.
+	Name string `json:"name"`
+	// The type of the changed asset.
+	Type       string     `json:"assetType"`
+	ChangeType ChangeType `json:"changeType"`
+	// The asset before the change. Unset for created assets.
+	Before *Asset `json:"before,omitempty"`
+	// The asset after the change. Unset for deleted assets.
+	After *Asset `json:"after,omitempty"`
+	// The addresses of the planned resources that change the asset.
+	TfplanAddress []string `json:"tfplanAddress,omitempty"`
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/cai2hcl"
+)
+
+const cai2hclDesc = `Convert CAI assets, in a JSON array or newline delimited JSON (like a CAI export), to Terraform configuration.`
+
+type cai2hclOptions struct {
+	rootOptions *rootOptions
+
+	assets            string
+	outputDir         string
+	importBlocks      bool
+	resolveReferences bool
+
+	stdin  io.Reader
+	stdout io.Writer
+}
+
+func newCai2hclCmd(rootOptions *rootOptions) *cobra.Command {
+	o := &cai2hclOptions{
+		rootOptions: rootOptions,
+		stdin:       os.Stdin,
+		stdout:      os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "cai2hcl",
+		Short: cai2hclDesc,
+		Long:  cai2hclDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			return o.run()
+		},
+	}
+	cmd.Flags().StringVar(&o.assets, "assets", "-", "path to the assets, or - to read them from stdin")
+	cmd.Flags().StringVar(&o.outputDir, "output-dir", "", "directory to write the configuration to, in a file per resource type, instead of stdout")
+	cmd.Flags().BoolVar(&o.importBlocks, "import-blocks", false, "emit an import block for each resource")
+	cmd.Flags().BoolVar(&o.resolveReferences, "resolve-references", false, "replace values referring to converted resources with references")
+	return cmd
+}
+
+func (o *cai2hclOptions) run() error {
+	logger, err := o.rootOptions.newLogger()
+	if err != nil {
+		return fmt.Errorf("creating logger: %w", err)
+	}
+	defer logger.Sync()
+
+	b, err := readInput(o.assets, o.stdin)
+	if err != nil {
+		return err
+	}
+	assets, err := parseAssets(b)
+	if err != nil {
+		return err
+	}
+
+	hcl, err := cai2hcl.Convert(assets, &cai2hcl.Options{
+		ErrorLogger:       logger,
+		EmitImportBlocks:  o.importBlocks,
+		ResolveReferences: o.resolveReferences,
+	})
+	if err != nil {
+		return err
+	}
+
+	if o.outputDir != "" {
+		return writeHCLByType(o.outputDir, hcl)
+	}
+	_, err = o.stdout.Write(hcl)
+	return err
+}
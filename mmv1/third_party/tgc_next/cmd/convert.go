@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+const convertDesc = `Convert between Terraform plans, CAI assets and Terraform configuration.`
+
+func newConvertCmd(rootOptions *rootOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert",
+		Short: convertDesc,
+		Long:  convertDesc,
+	}
+	cmd.AddCommand(newTfplan2caiCmd(rootOptions))
+	cmd.AddCommand(newCai2hclCmd(rootOptions))
+	return cmd
+}
@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/models"
+)
+
+var unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9_.-]+`)
+
+// readInput reads the file at path, or r if path is -.
+func readInput(path string, r io.Reader) ([]byte, error) {
+	if path == "-" {
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading stdin: %w", err)
+		}
+		return b, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return b, nil
+}
+
+// exportedAsset is an asset in either the format of caiasset.Asset, or of a
+// CAI export, which names the asset type asset_type.
+type exportedAsset struct {
+	caiasset.Asset
+	ExportedType string `json:"asset_type"`
+}
+
+// parseAssets parses assets in a JSON array, or in newline delimited JSON.
+func parseAssets(b []byte) ([]*caiasset.Asset, error) {
+	var exported []exportedAsset
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &exported); err != nil {
+			return nil, fmt.Errorf("parsing assets: %w", err)
+		}
+	} else {
+		dec := json.NewDecoder(bytes.NewReader(b))
+		for dec.More() {
+			var asset exportedAsset
+			if err := dec.Decode(&asset); err != nil {
+				return nil, fmt.Errorf("parsing assets: %w", err)
+			}
+			exported = append(exported, asset)
+		}
+	}
+
+	assets := make([]*caiasset.Asset, 0, len(exported))
+	for i := range exported {
+		asset := exported[i].Asset
+		if asset.Type == "" {
+			asset.Type = exported[i].ExportedType
+		}
+		assets = append(assets, &asset)
+	}
+	return assets, nil
+}
+
+// writeAssets writes the assets as an indented JSON array.
+func writeAssets(w io.Writer, assets []caiasset.Asset) error {
+	if assets == nil {
+		assets = []caiasset.Asset{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(assets); err != nil {
+		return fmt.Errorf("encoding assets: %w", err)
+	}
+	return nil
+}
+
+// writeAssetsByType writes the assets to dir, in a JSON file per asset type
+// (like compute.googleapis.com_Instance.json).
+func writeAssetsByType(dir string, assets []caiasset.Asset) error {
+	byType := make(map[string][]caiasset.Asset)
+	for _, asset := range assets {
+		byType[asset.Type] = append(byType[asset.Type], asset)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+	for assetType, typeAssets := range byType {
+		var buf bytes.Buffer
+		if err := writeAssets(&buf, typeAssets); err != nil {
+			return err
+		}
+		if err := writeFile(dir, assetType+".json", buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reportedResource is a resource in a conversion report written as JSON.
+type reportedResource struct {
+	Address string `json:"address"`
+	Kind    string `json:"kind"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+// writeReport writes the conversion report as an indented JSON array of the
+// planned resources, with the outcome of their conversion.
+func writeReport(w io.Writer, report *models.ConversionReport) error {
+	resources := make([]reportedResource, 0, len(report.Resources))
+	for _, r := range report.Resources {
+		resource := reportedResource{Address: r.Address, Kind: r.Kind, Status: string(r.Status)}
+		if r.Err != nil {
+			resource.Error = r.Err.Error()
+		}
+		resources = append(resources, resource)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(resources); err != nil {
+		return fmt.Errorf("encoding report: %w", err)
+	}
+	return nil
+}
+
+// printUnconverted prints the resources of the report that were not converted,
+// one per line, along with the reason.
+func printUnconverted(w io.Writer, report *models.ConversionReport) {
+	for _, r := range report.Unconverted() {
+		if r.Err != nil {
+			fmt.Fprintf(w, "%s: %s: %s\n", r.Address, r.Status, r.Err)
+		} else {
+			fmt.Fprintf(w, "%s: %s\n", r.Address, r.Status)
+		}
+	}
+}
+
+// conversionError returns an error if resources of the report failed to
+// convert. Most resource types don't have a converter yet, so unsupported and
+// skipped resources only fail the conversion with failOnUnconverted.
+func conversionError(report *models.ConversionReport, failOnUnconverted bool) error {
+	if unconverted := report.Unconverted(); failOnUnconverted && len(unconverted) > 0 {
+		return fmt.Errorf("%d of %d resources were not converted", len(unconverted), len(report.Resources))
+	}
+	if failed := report.WithStatus(models.ConversionFailed); len(failed) > 0 {
+		return fmt.Errorf("%d of %d resources failed to convert", len(failed), len(report.Resources))
+	}
+	return nil
+}
+
+// splitHCLByType splits the blocks of the configuration by the resource type
+// they declare, or import for import blocks.
+func splitHCLByType(src []byte) (map[string][]byte, error) {
+	f, diags := hclwrite.ParseConfig(src, "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("parsing configuration: %s", diags.Error())
+	}
+
+	byType := make(map[string]*hclwrite.File)
+	var types []string
+	for _, block := range f.Body().Blocks() {
+		resourceType := blockResourceType(block)
+		typeFile, ok := byType[resourceType]
+		if !ok {
+			typeFile = hclwrite.NewEmptyFile()
+			byType[resourceType] = typeFile
+			types = append(types, resourceType)
+		} else {
+			typeFile.Body().AppendNewline()
+		}
+		typeFile.Body().AppendBlock(block)
+	}
+
+	sort.Strings(types)
+	split := make(map[string][]byte, len(byType))
+	for _, t := range types {
+		split[t] = hclwrite.Format(byType[t].Bytes())
+	}
+	return split, nil
+}
+
+// blockResourceType returns the resource type declared by a resource block,
+// or imported by an import block.
+func blockResourceType(block *hclwrite.Block) string {
+	if block.Type() == "resource" && len(block.Labels()) > 0 {
+		return block.Labels()[0]
+	}
+	if block.Type() == "import" {
+		if to := block.Body().GetAttribute("to"); to != nil {
+			address := strings.TrimSpace(string(to.Expr().BuildTokens(nil).Bytes()))
+			if i := strings.Index(address, "."); i > 0 {
+				return address[:i]
+			}
+		}
+	}
+	return block.Type()
+}
+
+// writeHCLByType writes the configuration to dir, in a file per resource type
+// (like google_compute_instance.tf). Import blocks are written along with the
+// resources they import.
+func writeHCLByType(dir string, src []byte) error {
+	split, err := splitHCLByType(src)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+	for resourceType, b := range split {
+		if err := writeFile(dir, resourceType+".tf", b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeFile(dir, name string, b []byte) error {
+	path := filepath.Join(dir, unsafeFileNameChars.ReplaceAllString(name, "_"))
+	if err := os.WriteFile(path, b, 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return nil
+}
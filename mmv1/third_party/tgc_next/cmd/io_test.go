@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/models"
+)
+
+func TestParseAssets(t *testing.T) {
+	want := []*caiasset.Asset{
+		{
+			Name: "//compute.googleapis.com/projects/my-project/zones/us-central1-a/instances/my-instance",
+			Type: "compute.googleapis.com/Instance",
+		},
+		{
+			Name: "//cloudresourcemanager.googleapis.com/projects/my-project",
+			Type: "cloudresourcemanager.googleapis.com/Project",
+		},
+	}
+
+	tests := []struct {
+		name   string
+		assets string
+	}{
+		{
+			name: "json array",
+			assets: `[
+  {"name": "//compute.googleapis.com/projects/my-project/zones/us-central1-a/instances/my-instance", "assetType": "compute.googleapis.com/Instance"},
+  {"name": "//cloudresourcemanager.googleapis.com/projects/my-project", "assetType": "cloudresourcemanager.googleapis.com/Project"}
+]`,
+		},
+		{
+			name: "newline delimited",
+			assets: `{"name": "//compute.googleapis.com/projects/my-project/zones/us-central1-a/instances/my-instance", "assetType": "compute.googleapis.com/Instance"}
+{"name": "//cloudresourcemanager.googleapis.com/projects/my-project", "assetType": "cloudresourcemanager.googleapis.com/Project"}
+`,
+		},
+		{
+			name: "cai export",
+			assets: `{"name": "//compute.googleapis.com/projects/my-project/zones/us-central1-a/instances/my-instance", "asset_type": "compute.googleapis.com/Instance"}
+{"name": "//cloudresourcemanager.googleapis.com/projects/my-project", "asset_type": "cloudresourcemanager.googleapis.com/Project"}
+`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseAssets([]byte(test.assets))
+			if err != nil {
+				t.Fatalf("parseAssets() = %s, want = nil", err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("parseAssets() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSplitHCLByType(t *testing.T) {
+	src := `resource "google_compute_instance" "a" {
+  name = "a"
+}
+
+resource "google_project" "p" {
+  project_id = "p"
+}
+
+resource "google_compute_instance" "b" {
+  name = "b"
+}
+
+import {
+  to = google_compute_instance.a
+  id = "projects/p/zones/z/instances/a"
+}
+`
+	got, err := splitHCLByType([]byte(src))
+	if err != nil {
+		t.Fatalf("splitHCLByType() = %s, want = nil", err)
+	}
+
+	want := map[string]string{
+		"google_compute_instance": `resource "google_compute_instance" "a" {
+  name = "a"
+}
+
+resource "google_compute_instance" "b" {
+  name = "b"
+}
+
+import {
+  to = google_compute_instance.a
+  id = "projects/p/zones/z/instances/a"
+}
+`,
+		"google_project": `resource "google_project" "p" {
+  project_id = "p"
+}
+`,
+	}
+	gotStrings := make(map[string]string)
+	for k, v := range got {
+		gotStrings[k] = string(v)
+	}
+	if diff := cmp.Diff(want, gotStrings); diff != "" {
+		t.Errorf("splitHCLByType() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestConversionReportOutput(t *testing.T) {
+	report := &models.ConversionReport{
+		Resources: []models.ResourceConversion{
+			{Address: "google_foo.a", Kind: "google_foo", Status: models.ConversionUnsupported},
+			{Address: "google_project.a", Kind: "google_project", Status: models.ConversionFailed, Err: errors.New("invalid project")},
+			{Address: "google_project.b", Kind: "google_project", Status: models.ConversionConverted},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := writeReport(&buf, report); err != nil {
+		t.Fatalf("writeReport() = %s, want = nil", err)
+	}
+	wantReport := `[
+  {
+    "address": "google_foo.a",
+    "kind": "google_foo",
+    "status": "unsupported"
+  },
+  {
+    "address": "google_project.a",
+    "kind": "google_project",
+    "status": "failed",
+    "error": "invalid project"
+  },
+  {
+    "address": "google_project.b",
+    "kind": "google_project",
+    "status": "converted"
+  }
+]
+`
+	if diff := cmp.Diff(wantReport, buf.String()); diff != "" {
+		t.Errorf("writeReport() returned unexpected diff (-want +got):\n%s", diff)
+	}
+
+	buf.Reset()
+	printUnconverted(&buf, report)
+	wantUnconverted := `google_foo.a: unsupported
+google_project.a: failed: invalid project
+`
+	if diff := cmp.Diff(wantUnconverted, buf.String()); diff != "" {
+		t.Errorf("printUnconverted() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestConversionError(t *testing.T) {
+	unsupported := models.ResourceConversion{Address: "google_foo.a", Kind: "google_foo", Status: models.ConversionUnsupported}
+	skipped := models.ResourceConversion{Address: "google_foo.b", Kind: "google_foo", Status: models.ConversionSkipped}
+	failed := models.ResourceConversion{Address: "google_project.a", Kind: "google_project", Status: models.ConversionFailed, Err: errors.New("invalid project")}
+	converted := models.ResourceConversion{Address: "google_project.b", Kind: "google_project", Status: models.ConversionConverted}
+
+	cases := []struct {
+		name              string
+		resources         []models.ResourceConversion
+		failOnUnconverted bool
+		wantErr           string
+	}{
+		{
+			name:      "converted",
+			resources: []models.ResourceConversion{converted},
+		},
+		{
+			name:      "unsupported and skipped",
+			resources: []models.ResourceConversion{unsupported, skipped, converted},
+		},
+		{
+			name:              "unsupported and skipped with fail on unconverted",
+			resources:         []models.ResourceConversion{unsupported, skipped, converted},
+			failOnUnconverted: true,
+			wantErr:           "2 of 3 resources were not converted",
+		},
+		{
+			name:      "failed",
+			resources: []models.ResourceConversion{unsupported, failed, converted},
+			wantErr:   "1 of 3 resources failed to convert",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := conversionError(&models.ConversionReport{Resources: c.resources}, c.failOnUnconverted)
+			gotErr := ""
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if gotErr != c.wantErr {
+				t.Errorf("conversionError() = %q, want = %q", gotErr, c.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const rootCmdDesc = "Utilities for converting between Terraform and Cloud Asset Inventory (CAI) assets."
+
+type rootOptions struct {
+	verbose bool
+}
+
+func newRootCmd() (*cobra.Command, *rootOptions, error) {
+	o := &rootOptions{}
+	cmd := &cobra.Command{
+		Use:           "tgc",
+		Short:         rootCmdDesc,
+		Long:          rootCmdDesc,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	cmd.PersistentFlags().BoolVar(&o.verbose, "verbose", false, "log debug information")
+	cmd.AddCommand(newConvertCmd(o))
+	return cmd, o, nil
+}
+
+// newLogger returns a logger writing to stderr, logging warnings and errors
+// unless verbose.
+func (o *rootOptions) newLogger() (*zap.Logger, error) {
+	cfg := zap.NewDevelopmentConfig()
+	if !o.verbose {
+		cfg.Level = zap.NewAtomicLevelAt(zapcore.WarnLevel)
+	}
+	return cfg.Build()
+}
+
+// Execute is the entry-point for all commands.
+// This lets us keep all new command functions private.
+func Execute() {
+	rootCmd, _, err := newRootCmd()
+	if err != nil {
+		fmt.Printf("Error creating root logger: %s", err)
+		os.Exit(1)
+	}
+	err = rootCmd.Execute()
+	if err == nil {
+		os.Exit(0)
+	} else {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai"
+)
+
+const tfplan2caiDesc = `Convert a Terraform plan in JSON format (from terraform show -json) to CAI assets.`
+
+type tfplan2caiOptions struct {
+	rootOptions *rootOptions
+
+	plan              string
+	outputDir         string
+	offline           bool
+	project           string
+	region            string
+	zone              string
+	userAgent         string
+	ancestryCacheFile string
+	ancestryExport    string
+	failFast          bool
+	failOnUnconverted bool
+	reportFile        string
+
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func newTfplan2caiCmd(rootOptions *rootOptions) *cobra.Command {
+	o := &tfplan2caiOptions{
+		rootOptions: rootOptions,
+		stdin:       os.Stdin,
+		stdout:      os.Stdout,
+		stderr:      os.Stderr,
+	}
+	cmd := &cobra.Command{
+		Use:   "tfplan2cai",
+		Short: tfplan2caiDesc,
+		Long:  tfplan2caiDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			return o.run()
+		},
+	}
+	cmd.Flags().StringVar(&o.plan, "plan", "-", "path to the JSON plan, or - to read it from stdin")
+	cmd.Flags().StringVar(&o.outputDir, "output-dir", "", "directory to write the assets to, in a file per asset type, instead of stdout")
+	cmd.Flags().BoolVar(&o.offline, "offline", false, "do not make network requests")
+	cmd.Flags().StringVar(&o.project, "project", "", "default project of resources without one")
+	cmd.Flags().StringVar(&o.region, "region", "", "default region of resources without one")
+	cmd.Flags().StringVar(&o.zone, "zone", "", "default zone of resources without one")
+	cmd.Flags().StringVar(&o.userAgent, "user-agent", "", "user agent of API requests")
+	cmd.Flags().StringVar(&o.ancestryCacheFile, "ancestry-cache", "", "path to a JSON object mapping projects and folders (like projects/123) to their ancestry path (like organizations/456/folders/789)")
+	cmd.Flags().StringVar(&o.ancestryExport, "ancestry-export", "", "path to a CAI export of the resource hierarchy, to build the ancestry cache from")
+	cmd.Flags().BoolVar(&o.failFast, "fail-fast", false, "stop at the first resource that fails to convert")
+	cmd.Flags().BoolVar(&o.failOnUnconverted, "fail-on-unconverted", false, "fail if any resource is not converted, including unsupported and skipped ones, rather than only on failures")
+	cmd.Flags().StringVar(&o.reportFile, "report", "", "path to write a JSON report of the outcome of converting each resource to")
+	return cmd
+}
+
+func (o *tfplan2caiOptions) run() error {
+	logger, err := o.rootOptions.newLogger()
+	if err != nil {
+		return fmt.Errorf("creating logger: %w", err)
+	}
+	defer logger.Sync()
+
+	plan, err := readInput(o.plan, o.stdin)
+	if err != nil {
+		return err
+	}
+
+	var ancestryCache map[string]string
+	if o.ancestryCacheFile != "" {
+		b, err := os.ReadFile(o.ancestryCacheFile)
+		if err != nil {
+			return fmt.Errorf("reading ancestry cache: %w", err)
+		}
+		if err := json.Unmarshal(b, &ancestryCache); err != nil {
+			return fmt.Errorf("parsing ancestry cache %s: %w", o.ancestryCacheFile, err)
+		}
+	}
+
+	assets, report, err := tfplan2cai.Convert(context.Background(), plan, &tfplan2cai.Options{
+		ErrorLogger:        logger,
+		Offline:            o.offline,
+		DefaultProject:     o.project,
+		DefaultRegion:      o.region,
+		DefaultZone:        o.zone,
+		UserAgent:          o.userAgent,
+		AncestryCache:      ancestryCache,
+		AncestryExportFile: o.ancestryExport,
+		FailFast:           o.failFast,
+	})
+	if err != nil {
+		return err
+	}
+
+	if o.outputDir != "" {
+		err = writeAssetsByType(o.outputDir, assets)
+	} else {
+		err = writeAssets(o.stdout, assets)
+	}
+	if err != nil {
+		return err
+	}
+
+	if o.reportFile != "" {
+		var buf bytes.Buffer
+		if err := writeReport(&buf, report); err != nil {
+			return err
+		}
+		if err := os.WriteFile(o.reportFile, buf.Bytes(), 0644); err != nil {
+			return fmt.Errorf("writing report: %w", err)
+		}
+	}
+
+	if len(report.Unconverted()) > 0 {
+		printUnconverted(o.stderr, report)
+	}
+	return conversionError(report, o.failOnUnconverted)
+}
@@ -0,0 +1,9 @@
+package main
+
+import (
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/cmd"
+)
+
+func main() {
+	cmd.Execute()
+}
@@ -0,0 +1,194 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// Meta-arguments and blocks of resources, which are not part of their values.
+var metaArguments = map[string]bool{
+	"count":       true,
+	"depends_on":  true,
+	"for_each":    true,
+	"provider":    true,
+	"lifecycle":   true,
+	"provisioner": true,
+	"connection":  true,
+	"dynamic":     true,
+}
+
+var indexSegment = regexp.MustCompile(`^\d+$`)
+
+// terraformResource is a resource block of a Terraform configuration, holding
+// the values that can be evaluated without the other resources.
+type terraformResource struct {
+	Type   string
+	Name   string
+	Values map[string]interface{}
+}
+
+func (r terraformResource) Address() string {
+	return r.Type + "." + r.Name
+}
+
+// parseResources parses the resource blocks of a Terraform configuration.
+// Attributes that can't be evaluated on their own, such as references to
+// other resources, are left out of the values.
+func parseResources(config string) ([]terraformResource, error) {
+	file, diags := hclsyntax.ParseConfig([]byte(config), "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("parsing config: %s", diags.Error())
+	}
+
+	var resources []terraformResource
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		if block.Type != "resource" || len(block.Labels) != 2 {
+			continue
+		}
+		values, err := bodyValues(block.Body)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", block.Labels[0], block.Labels[1], err)
+		}
+		resources = append(resources, terraformResource{
+			Type:   block.Labels[0],
+			Name:   block.Labels[1],
+			Values: values,
+		})
+	}
+	return resources, nil
+}
+
+func bodyValues(body *hclsyntax.Body) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	for name, attr := range body.Attributes {
+		if metaArguments[name] {
+			continue
+		}
+		v, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() || !v.IsWhollyKnown() || v.IsNull() {
+			continue
+		}
+		b, err := ctyjson.Marshal(v, v.Type())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		var value interface{}
+		if err := json.Unmarshal(b, &value); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		values[name] = value
+	}
+
+	for _, block := range body.Blocks {
+		if metaArguments[block.Type] {
+			continue
+		}
+		blockValues, err := bodyValues(block.Body)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", block.Type, err)
+		}
+		list, _ := values[block.Type].([]interface{})
+		values[block.Type] = append(list, blockValues)
+	}
+	return values, nil
+}
+
+// planJSON builds a Terraform JSON plan creating the resources.
+func planJSON(resources []terraformResource) ([]byte, error) {
+	changes := make([]map[string]interface{}, 0, len(resources))
+	for _, r := range resources {
+		changes = append(changes, map[string]interface{}{
+			"address":       r.Address(),
+			"mode":          "managed",
+			"type":          r.Type,
+			"name":          r.Name,
+			"provider_name": "registry.terraform.io/hashicorp/google-beta",
+			"change": map[string]interface{}{
+				"actions":       []string{"create"},
+				"before":        nil,
+				"after":         r.Values,
+				"after_unknown": map[string]interface{}{},
+			},
+		})
+	}
+	return json.Marshal(map[string]interface{}{
+		"format_version":    "1.2",
+		"terraform_version": "1.9.0",
+		"resource_changes":  changes,
+	})
+}
+
+// flatten flattens the values into a map of attribute paths, like
+// settings.0.tier, to scalar values. Empty collections are left out.
+func flatten(prefix string, value interface{}, flat map[string]interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			flatten(joinPath(prefix, k), e, flat)
+		}
+	case []interface{}:
+		for i, e := range v {
+			flatten(joinPath(prefix, fmt.Sprint(i)), e, flat)
+		}
+	default:
+		flat[prefix] = v
+	}
+}
+
+func joinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+// isLossy reports whether the attribute path is one of the lossy fields, or
+// nested in one. Lossy fields are given without list indexes.
+func isLossy(path string, lossyFields []string) bool {
+	var segments []string
+	for _, s := range strings.Split(path, ".") {
+		if !indexSegment.MatchString(s) {
+			segments = append(segments, s)
+		}
+	}
+	p := strings.Join(segments, ".")
+	for _, f := range lossyFields {
+		if p == f || strings.HasPrefix(p, f+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// diffValues lists the values of want that are missing or different in got,
+// ignoring the lossy fields. Values in got that aren't in want, such as
+// defaults set by the conversion, are not compared.
+func diffValues(want, got map[string]interface{}, lossyFields []string) []string {
+	wantFlat := make(map[string]interface{})
+	flatten("", want, wantFlat)
+	gotFlat := make(map[string]interface{})
+	flatten("", got, gotFlat)
+
+	var diffs []string
+	for path, w := range wantFlat {
+		if isLossy(path, lossyFields) {
+			continue
+		}
+		g, ok := gotFlat[path]
+		switch {
+		case !ok:
+			diffs = append(diffs, fmt.Sprintf("%s: want %v, got nothing", path, w))
+		case fmt.Sprint(g) != fmt.Sprint(w):
+			diffs = append(diffs, fmt.Sprintf("%s: want %v, got %v", path, w, g))
+		}
+	}
+	sort.Strings(diffs)
+	return diffs
+}
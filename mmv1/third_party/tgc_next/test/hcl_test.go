@@ -0,0 +1,144 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseResources(t *testing.T) {
+	config := `
+resource "google_storage_bucket" "bucket" {
+  provider = google-beta
+  name     = "my-bucket"
+  location = "US"
+  project  = google_project.project.project_id
+  labels = {
+    env = "test"
+  }
+
+  lifecycle_rule {
+    condition {
+      age = 3
+    }
+  }
+  lifecycle {
+    prevent_destroy = true
+  }
+}
+
+data "google_project" "project" {
+}
+`
+	got, err := parseResources(config)
+	if err != nil {
+		t.Fatalf("parseResources() = %s, want = nil", err)
+	}
+
+	want := []terraformResource{
+		{
+			Type: "google_storage_bucket",
+			Name: "bucket",
+			Values: map[string]interface{}{
+				"name":     "my-bucket",
+				"location": "US",
+				"labels":   map[string]interface{}{"env": "test"},
+				"lifecycle_rule": []interface{}{
+					map[string]interface{}{
+						"condition": []interface{}{
+							map[string]interface{}{"age": float64(3)},
+						},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseResources() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestPlanJSON(t *testing.T) {
+	plan, err := planJSON([]terraformResource{
+		{
+			Type:   "google_storage_bucket",
+			Name:   "bucket",
+			Values: map[string]interface{}{"name": "my-bucket"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("planJSON() = %s, want = nil", err)
+	}
+
+	var got struct {
+		ResourceChanges []struct {
+			Address string `json:"address"`
+			Change  struct {
+				Actions []string               `json:"actions"`
+				After   map[string]interface{} `json:"after"`
+			} `json:"change"`
+		} `json:"resource_changes"`
+	}
+	if err := json.Unmarshal(plan, &got); err != nil {
+		t.Fatalf("unmarshaling plan: %s", err)
+	}
+	if len(got.ResourceChanges) != 1 {
+		t.Fatalf("planJSON() has %d resource changes, want 1", len(got.ResourceChanges))
+	}
+	rc := got.ResourceChanges[0]
+	if rc.Address != "google_storage_bucket.bucket" || !cmp.Equal(rc.Change.Actions, []string{"create"}) || rc.Change.After["name"] != "my-bucket" {
+		t.Errorf("planJSON() = %s, want a change creating google_storage_bucket.bucket", plan)
+	}
+}
+
+func TestDiffValues(t *testing.T) {
+	want := map[string]interface{}{
+		"name":     "my-bucket",
+		"location": "US",
+		"settings": []interface{}{
+			map[string]interface{}{"tier": "STANDARD", "size": float64(10)},
+		},
+	}
+	got := map[string]interface{}{
+		"name":     "my-bucket",
+		"location": "EU",
+		"project":  "my-project",
+		"settings": []interface{}{
+			map[string]interface{}{"size": float64(10)},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		lossyFields []string
+		want        []string
+	}{
+		{
+			name: "no lossy fields",
+			want: []string{
+				"location: want US, got EU",
+				"settings.0.tier: want STANDARD, got nothing",
+			},
+		},
+		{
+			name:        "lossy fields",
+			lossyFields: []string{"location", "settings.tier"},
+		},
+		{
+			name:        "lossy parent",
+			lossyFields: []string{"settings"},
+			want: []string{
+				"location: want US, got EU",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diffs := diffValues(want, got, test.lossyFields)
+			if diff := cmp.Diff(test.want, diffs); diff != "" {
+				t.Errorf("diffValues() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
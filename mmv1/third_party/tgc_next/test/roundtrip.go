@@ -0,0 +1,148 @@
+// Package test provides a round-trip conformance test of tfplan2cai and
+// cai2hcl, converting Terraform configurations to CAI assets and back.
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/cai2hcl"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai"
+	tfplan2caiconverters "github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/converters"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/models"
+)
+
+// Values of the provider configuration, matching the values in the
+// documentation of the resource examples.
+const (
+	defaultProject  = "my-project-name"
+	defaultRegion   = "us-west1"
+	defaultZone     = "us-west1-a"
+	defaultAncestry = "organizations/123456789/projects/" + defaultProject
+)
+
+// RoundTripCase is a Terraform configuration converted to CAI assets and back
+// to HCL by a round-trip test.
+type RoundTripCase struct {
+	// The Terraform resource type under test
+	ResourceType string
+
+	// The Terraform configuration, such as a resource example
+	Config string
+
+	// Attribute paths of the resource type that are known to be lost in the
+	// conversion, without list indexes (like settings.tier)
+	LossyFields []string
+}
+
+// AssertRoundTrip converts the resources of the configuration through
+// tfplan2cai and cai2hcl offline, and checks that the resulting HCL holds the
+// values of the resources of the type under test, except for the lossy fields.
+// Values of the configuration that can't be evaluated on their own, such as
+// references to other resources, are not checked.
+func AssertRoundTrip(t *testing.T, c RoundTripCase) {
+	t.Helper()
+
+	if _, ok := tfplan2caiconverters.ConverterMap[c.ResourceType]; !ok {
+		t.Skipf("%s is not supported by tfplan2cai", c.ResourceType)
+	}
+
+	want, err := parseResources(c.Config)
+	if err != nil {
+		t.Fatalf("config: %s", err)
+	}
+	plan, err := planJSON(want)
+	if err != nil {
+		t.Fatalf("building plan: %s", err)
+	}
+
+	got, report, err := roundTrip(plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Resources of other types are not checked, so only the resources under
+	// test need to be converted.
+	for _, r := range report.Unconverted() {
+		if r.Kind != c.ResourceType {
+			continue
+		}
+		if r.Err != nil {
+			t.Errorf("%s: not converted to CAI assets (%s): %s", r.Address, r.Status, r.Err)
+		} else {
+			t.Errorf("%s: not converted to CAI assets (%s)", r.Address, r.Status)
+		}
+	}
+	if t.Failed() {
+		return
+	}
+
+	used := make(map[int]bool)
+	for _, w := range want {
+		if w.Type != c.ResourceType {
+			continue
+		}
+
+		// The names of the converted resources are derived from the assets,
+		// so resources are matched by their values.
+		match := -1
+		var matchDiffs []string
+		for i, g := range got {
+			if g.Type != w.Type || used[i] {
+				continue
+			}
+			diffs := diffValues(w.Values, g.Values, c.LossyFields)
+			if match == -1 || len(diffs) < len(matchDiffs) {
+				match, matchDiffs = i, diffs
+			}
+		}
+
+		if match == -1 {
+			t.Errorf("%s: no %s resource converted back to HCL", w.Address(), w.Type)
+			continue
+		}
+		used[match] = true
+		if len(matchDiffs) > 0 {
+			t.Errorf("%s: values lost in round trip:\n%s", w.Address(), strings.Join(matchDiffs, "\n"))
+		}
+	}
+}
+
+// roundTrip converts the plan to CAI assets, and the assets back to resources,
+// along with the report of the conversion to CAI assets.
+func roundTrip(plan []byte) ([]terraformResource, *models.ConversionReport, error) {
+	logger := zap.NewNop()
+	assets, report, err := tfplan2cai.Convert(context.Background(), plan, &tfplan2cai.Options{
+		ErrorLogger:    logger,
+		Offline:        true,
+		DefaultProject: defaultProject,
+		DefaultRegion:  defaultRegion,
+		DefaultZone:    defaultZone,
+		AncestryCache: map[string]string{
+			defaultProject: defaultAncestry,
+		},
+		FailFast: true,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("tfplan2cai: %w", err)
+	}
+
+	assetPtrs := make([]*caiasset.Asset, 0, len(assets))
+	for i := range assets {
+		assetPtrs = append(assetPtrs, &assets[i])
+	}
+	hcl, err := cai2hcl.Convert(assetPtrs, &cai2hcl.Options{ErrorLogger: logger})
+	if err != nil {
+		return nil, nil, fmt.Errorf("cai2hcl: %w", err)
+	}
+
+	resources, err := parseResources(string(hcl))
+	if err != nil {
+		return nil, nil, fmt.Errorf("cai2hcl output: %w\n%s", err, hcl)
+	}
+	return resources, report, nil
+}
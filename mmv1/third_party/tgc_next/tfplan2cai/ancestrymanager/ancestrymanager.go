@@ -159,7 +159,7 @@ func (m *manager) fetchAncestors(config *transport_tpg.Config, tfData tpgresourc
 		} else {
 			return []string{unknownOrg}, nil
 		}
-	case "cloudresourcemanager.googleapis.com/Organization":
+	case "cloudresourcemanager.googleapis.com/Organization", "orgpolicy.googleapis.com/CustomConstraint":
 		if !orgOK {
 			return nil, fmt.Errorf("organization id not found in terraform data")
 		}
@@ -353,6 +353,11 @@ func (m *manager) getProjectFromResource(d tpgresource.TerraformResourceData, co
 		res, ok = d.GetOk("project_id")
 		if ok {
 			return res.(string), nil
+		}
+		// The parent of organization policies set on the project.
+		res, ok = d.GetOk("parent")
+		if ok && strings.HasPrefix(res.(string), projectPrefix) {
+			return res.(string), nil
 		} else {
 			m.errorLogger.Warn(fmt.Sprintf("Failed to retrieve project_id for %s from resource", cai.Name))
 		}
@@ -387,7 +392,11 @@ func (m *manager) SetAncestors(d tpgresource.TerraformResourceData, config *tran
 		return fmt.Errorf("getting resource ancestry or parent failed: %w", err)
 	}
 
-	cai.Resource.Parent = parent
+	// Assets of policies set on a resource, like IAM policies and organization
+	// policies, have no resource data.
+	if cai.Resource != nil {
+		cai.Resource.Parent = parent
+	}
 	cai.Ancestors = ancestors
 	return nil
 }
@@ -0,0 +1,106 @@
+package ancestrymanager
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/models"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.uber.org/zap"
+)
+
+func TestSetAncestors(t *testing.T) {
+	cases := []struct {
+		name       string
+		data       *models.FakeResourceDataWithMeta
+		asset      *caiasset.Asset
+		want       []string
+		wantParent string
+	}{
+		{
+			name: "project",
+			data: models.NewFakeResourceDataWithMeta(
+				"google_project",
+				map[string]*schema.Schema{
+					"project_id": {Type: schema.TypeString, Optional: true},
+				},
+				map[string]interface{}{
+					"project_id": "foo",
+				},
+				false,
+				"google_project.foo",
+			),
+			asset: &caiasset.Asset{
+				Name:     "//cloudresourcemanager.googleapis.com/projects/foo",
+				Type:     "cloudresourcemanager.googleapis.com/Project",
+				Resource: &caiasset.AssetResource{},
+			},
+			want:       []string{"projects/foo", "folders/bar", "organizations/qux"},
+			wantParent: "//cloudresourcemanager.googleapis.com/folders/bar",
+		},
+		{
+			// IAM assets only carry a policy, so they have no resource to set
+			// the parent on.
+			name: "project IAM policy",
+			data: models.NewFakeResourceDataWithMeta(
+				"google_project_iam_member",
+				map[string]*schema.Schema{
+					"project": {Type: schema.TypeString, Required: true},
+					"role":    {Type: schema.TypeString, Required: true},
+					"member":  {Type: schema.TypeString, Required: true},
+				},
+				map[string]interface{}{
+					"project": "foo",
+					"role":    "roles/viewer",
+					"member":  "user:jane@example.com",
+				},
+				false,
+				"google_project_iam_member.foo",
+			),
+			asset: &caiasset.Asset{
+				Name: "//cloudresourcemanager.googleapis.com/projects/foo",
+				Type: "cloudresourcemanager.googleapis.com/Project",
+				IAMPolicy: &caiasset.IAMPolicy{
+					Bindings: []caiasset.IAMBinding{
+						{Role: "roles/viewer", Members: []string{"user:jane@example.com"}},
+					},
+				},
+			},
+			want: []string{"projects/foo", "folders/bar", "organizations/qux"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cfg := &transport_tpg.Config{}
+			ancestryManager := &manager{
+				errorLogger:   zap.NewExample(),
+				ancestorCache: make(map[string][]string),
+			}
+			if err := ancestryManager.initAncestryCache(map[string]string{
+				"projects/foo": "organizations/qux/folders/bar/projects/foo",
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := ancestryManager.SetAncestors(c.data, cfg, c.asset); err != nil {
+				t.Fatalf("SetAncestors(%v) = %s, want = nil", c.asset, err)
+			}
+			if diff := cmp.Diff(c.want, c.asset.Ancestors); diff != "" {
+				t.Errorf("SetAncestors(%v) returned unexpected ancestors diff (-want +got):\n%s", c.asset, diff)
+			}
+			if c.asset.Resource == nil {
+				if c.wantParent != "" {
+					t.Errorf("SetAncestors(%v) resource = nil, want parent %s", c.asset, c.wantParent)
+				}
+				return
+			}
+			if c.asset.Resource.Parent != c.wantParent {
+				t.Errorf("SetAncestors(%v) parent = %s, want = %s", c.asset, c.asset.Resource.Parent, c.wantParent)
+			}
+		})
+	}
+}
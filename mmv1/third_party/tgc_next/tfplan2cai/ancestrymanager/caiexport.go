@@ -0,0 +1,111 @@
+package ancestrymanager
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+)
+
+const crmAssetNamePrefix = "//cloudresourcemanager.googleapis.com/"
+
+// caiExportAsset holds the fields of an asset in a Cloud Asset Inventory
+// export that are needed to build the ancestry cache.
+type caiExportAsset struct {
+	Name      string   `json:"name"`
+	Ancestors []string `json:"ancestors"`
+	Resource  *struct {
+		Data struct {
+			ProjectID string `json:"projectId"`
+		} `json:"data"`
+	} `json:"resource"`
+}
+
+// ReadCaiExport builds ancestry cache entries, as accepted by New, from a Cloud
+// Asset Inventory export. The export can be either newline delimited JSON, as
+// written by `gcloud asset export`, or a JSON array of assets. The ancestors of
+// every asset are added to the entries, and project assets are also added by
+// project ID, so that resources referring to a project by ID can be resolved.
+func ReadCaiExport(r io.Reader) (map[string]string, error) {
+	br := bufio.NewReader(r)
+	isArray, err := startsWithArray(br)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(br)
+	if isArray {
+		if _, err := dec.Token(); err != nil {
+			return nil, fmt.Errorf("reading CAI export: %w", err)
+		}
+	}
+
+	entries := make(map[string]string)
+	for dec.More() {
+		var asset caiExportAsset
+		if err := dec.Decode(&asset); err != nil {
+			return nil, fmt.Errorf("reading CAI export: %w", err)
+		}
+		if len(asset.Ancestors) == 0 {
+			continue
+		}
+		for _, ancestor := range asset.Ancestors {
+			if !strings.HasPrefix(ancestor, projectPrefix) && !strings.HasPrefix(ancestor, folderPrefix) && !strings.HasPrefix(ancestor, orgPrefix) {
+				return nil, fmt.Errorf("reading CAI export: asset %s has invalid ancestor %q", asset.Name, ancestor)
+			}
+		}
+
+		path := ConvertToAncestryPath(asset.Ancestors)
+		if _, ok := entries[asset.Ancestors[0]]; !ok {
+			entries[asset.Ancestors[0]] = path
+		}
+		if strings.HasPrefix(asset.Name, crmAssetNamePrefix+projectPrefix) && asset.Resource != nil {
+			if projectID := asset.Resource.Data.ProjectID; projectID != "" {
+				if _, ok := entries[projectID]; !ok {
+					entries[projectID] = path
+				}
+			}
+		}
+	}
+	return entries, nil
+}
+
+// ReadCaiExportFile reads the Cloud Asset Inventory export at path, as
+// ReadCaiExport does, and adds entries to the result. Entries take precedence
+// over the export.
+func ReadCaiExportFile(path string, entries map[string]string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening ancestry export: %w", err)
+	}
+	defer f.Close()
+
+	merged, err := ReadCaiExport(f)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range entries {
+		merged[k] = v
+	}
+	return merged, nil
+}
+
+// startsWithArray reports whether the first non-whitespace character of br
+// starts a JSON array, without consuming it.
+func startsWithArray(br *bufio.Reader) (bool, error) {
+	for {
+		r, _, err := br.ReadRune()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("reading CAI export: %w", err)
+		}
+		if !unicode.IsSpace(r) {
+			return r == '[', br.UnreadRune()
+		}
+	}
+}
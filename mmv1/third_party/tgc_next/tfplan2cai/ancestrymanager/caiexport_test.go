@@ -0,0 +1,86 @@
+package ancestrymanager
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadCaiExport(t *testing.T) {
+	tests := []struct {
+		name   string
+		export string
+		want   map[string]string
+	}{
+		{
+			name:   "empty",
+			export: "",
+			want:   map[string]string{},
+		},
+		{
+			name: "newline delimited",
+			export: `{"name":"//cloudresourcemanager.googleapis.com/projects/123","asset_type":"cloudresourcemanager.googleapis.com/Project","resource":{"data":{"projectId":"test-proj","projectNumber":"123"}},"ancestors":["projects/123","folders/456","organizations/789"]}
+{"name":"//cloudresourcemanager.googleapis.com/folders/456","asset_type":"cloudresourcemanager.googleapis.com/Folder","ancestors":["folders/456","organizations/789"]}
+`,
+			want: map[string]string{
+				"projects/123": "organization/789/folder/456/project/123",
+				"test-proj":    "organization/789/folder/456/project/123",
+				"folders/456":  "organization/789/folder/456",
+			},
+		},
+		{
+			name: "json array",
+			export: `[
+  {"name":"//cloudresourcemanager.googleapis.com/projects/123","assetType":"cloudresourcemanager.googleapis.com/Project","resource":{"data":{"projectId":"test-proj"}},"ancestors":["projects/123","organizations/789"]}
+]`,
+			want: map[string]string{
+				"projects/123": "organization/789/project/123",
+				"test-proj":    "organization/789/project/123",
+			},
+		},
+		{
+			name: "ancestors of other resources",
+			export: `{"name":"//storage.googleapis.com/my-bucket","asset_type":"storage.googleapis.com/Bucket","resource":{"data":{"name":"my-bucket"}},"ancestors":["projects/123","folders/456","organizations/789"]}
+{"name":"//cloudresourcemanager.googleapis.com/organizations/789","asset_type":"cloudresourcemanager.googleapis.com/Organization"}`,
+			want: map[string]string{
+				"projects/123": "organization/789/folder/456/project/123",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ReadCaiExport(strings.NewReader(test.export))
+			if err != nil {
+				t.Fatalf("ReadCaiExport() = %s, want = nil", err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ReadCaiExport() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadCaiExport_Fail(t *testing.T) {
+	tests := []struct {
+		name   string
+		export string
+	}{
+		{
+			name:   "invalid json",
+			export: `{"name":`,
+		},
+		{
+			name:   "invalid ancestor",
+			export: `{"name":"//storage.googleapis.com/my-bucket","ancestors":["buckets/my-bucket"]}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ReadCaiExport(strings.NewReader(test.export))
+			if err == nil {
+				t.Fatalf("ReadCaiExport() = nil, want = err")
+			}
+		})
+	}
+}
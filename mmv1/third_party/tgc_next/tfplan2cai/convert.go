@@ -3,14 +3,18 @@ package tfplan2cai
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"go.uber.org/zap"
 
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/ancestrymanager"
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/converters"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/models"
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/resolvers"
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/transport"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 )
 
 // Options struct to avoid updating function signatures all along the pipe.
@@ -25,37 +29,99 @@ type Options struct {
 	// Map hierarchy resource (like projects/<number> or folders/<number>)
 	// to an ancestry path (like organizations/123/folders/456/projects/789)
 	AncestryCache map[string]string
+	// Path to a Cloud Asset Inventory export (newline delimited JSON or a JSON
+	// array) of the resource hierarchy, used to build the ancestry cache.
+	// Entries in AncestryCache take precedence over the export.
+	AncestryExportFile string
+	// Map asset names (like //cloudresourcemanager.googleapis.com/projects/my-project)
+	// to their existing IAM policy, which IAM member and binding changes are
+	// merged with instead of fetching the policy
+	IAMPolicyCache map[string]*caiasset.IAMPolicy
+	// Whether to stop converting at the first resource that fails to convert,
+	// rather than recording the failure in the report and continuing
+	FailFast bool
 }
 
-// Convert converts terraform json plan to CAI Assets.
-func Convert(ctx context.Context, jsonPlan []byte, o *Options) ([]caiasset.Asset, error) {
+// Convert converts terraform json plan to CAI Assets, along with a report of
+// the result of converting each planned resource.
+func Convert(ctx context.Context, jsonPlan []byte, o *Options) ([]caiasset.Asset, *models.ConversionReport, error) {
 	if o == nil || o.ErrorLogger == nil {
-		return nil, fmt.Errorf("logger is not initialized")
+		return nil, nil, fmt.Errorf("logger is not initialized")
 	}
 
 	resourceDataMap := resolvers.NewDefaultPreResolver(o.ErrorLogger).Resolve(jsonPlan)
 
 	// TODO: add advanced resolvers for resources
 
-	// Set up config and ancestry manager using the same user agent.
-	// Config and ancestry manager are shared among resources.
+	cfg, ancestryManager, err := newConfigAndAncestryManager(ctx, o)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := &models.ConversionReport{}
+	assets, err := convertResourceData(resourceDataMap, cfg, ancestryManager, o, report)
+	report.Sort()
+	if err != nil {
+		return nil, report, err
+	}
+	return assets, report, nil
+}
+
+// newConfigAndAncestryManager sets up config and ancestry manager using the
+// same user agent. Config and ancestry manager are shared among resources.
+func newConfigAndAncestryManager(ctx context.Context, o *Options) (*transport_tpg.Config, ancestrymanager.AncestryManager, error) {
 	cfg, err := transport.NewConfig(ctx, o.DefaultProject, o.DefaultZone, o.DefaultRegion, o.Offline, o.UserAgent)
 	if err != nil {
-		return nil, fmt.Errorf("building config: %w", err)
+		return nil, nil, fmt.Errorf("building config: %w", err)
 	}
 
-	ancestryManager, err := ancestrymanager.New(cfg, o.Offline, o.AncestryCache, o.ErrorLogger)
+	ancestryCache, err := ancestryCacheEntries(o)
 	if err != nil {
-		return nil, fmt.Errorf("building ancestry manager: %w", err)
+		return nil, nil, err
 	}
 
-	var assets []caiasset.Asset
-	for _, resourceDataList := range resourceDataMap {
-		convertedAssets, err := converters.ConvertResource(resourceDataList, cfg, ancestryManager, o.ErrorLogger)
-		if err != nil {
-			return nil, fmt.Errorf("tfplan2ai converting: %w", err)
+	ancestryManager, err := ancestrymanager.New(cfg, o.Offline, ancestryCache, o.ErrorLogger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("building ancestry manager: %w", err)
+	}
+	return cfg, ancestryManager, nil
+}
+
+// convertResourceData converts the resource data into merged CAI assets,
+// recording the result of each conversion in report.
+func convertResourceData(resourceDataMap map[string][]*models.FakeResourceDataWithMeta, cfg *transport_tpg.Config, ancestryManager ancestrymanager.AncestryManager, o *Options, report *models.ConversionReport) ([]caiasset.Asset, error) {
+	merger := converters.NewAssetMerger(cfg, ancestryManager, o.Offline, o.IAMPolicyCache, o.ErrorLogger)
+
+	addresses := make([]string, 0, len(resourceDataMap))
+	for address := range resourceDataMap {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	// Deletions are converted first, so that the merged assets are deterministic
+	// when, for example, an IAM binding replaces an IAM member.
+	for _, deleted := range []bool{true, false} {
+		for _, address := range addresses {
+			var resourceDataList []*models.FakeResourceDataWithMeta
+			for _, rd := range resourceDataMap[address] {
+				if rd.IsDeleted() == deleted {
+					resourceDataList = append(resourceDataList, rd)
+				}
+			}
+
+			if err := converters.ConvertResource(resourceDataList, cfg, merger, o.ErrorLogger, report, o.FailFast); err != nil {
+				return nil, fmt.Errorf("tfplan2ai converting: %w", err)
+			}
 		}
-		assets = append(assets, convertedAssets...)
 	}
-	return assets, nil
+	return merger.Assets(), nil
+}
+
+// ancestryCacheEntries combines the ancestry cache entries read from the
+// ancestry export file with the entries of the ancestry cache.
+func ancestryCacheEntries(o *Options) (map[string]string, error) {
+	if o.AncestryExportFile == "" {
+		return o.AncestryCache, nil
+	}
+	return ancestrymanager.ReadCaiExportFile(o.AncestryExportFile, o.AncestryCache)
 }
@@ -0,0 +1,164 @@
+package tfplan2cai
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/converters"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/models"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/resolvers"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/tfplan"
+)
+
+// ConvertChanges converts terraform json plan to the planned changes of CAI
+// assets. Each change holds the asset before and after the change, so that
+// removals, such as a deleted IAM binding, can be evaluated. The report covers
+// the conversion of the planned state of each resource.
+func ConvertChanges(ctx context.Context, jsonPlan []byte, o *Options) ([]caiasset.AssetChange, *models.ConversionReport, error) {
+	if o == nil || o.ErrorLogger == nil {
+		return nil, nil, fmt.Errorf("logger is not initialized")
+	}
+
+	changes, err := tfplan.ReadResourceChanges(jsonPlan)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolver := resolvers.NewDefaultPreResolver(o.ErrorLogger)
+	afterDataMap := plannedResourceData(resolver.AddResourceChanges(changes))
+	beforeDataMap := resolver.AddPriorResourceChanges(changes)
+
+	cfg, ancestryManager, err := newConfigAndAncestryManager(ctx, o)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := &models.ConversionReport{}
+	after, err := convertResourceData(afterDataMap, cfg, ancestryManager, o, report)
+	report.Sort()
+	if err != nil {
+		return nil, report, err
+	}
+
+	// The prior state is of the same resources, so it isn't reported again.
+	before, err := convertResourceData(beforeDataMap, cfg, ancestryManager, o, &models.ConversionReport{})
+	if err != nil {
+		return nil, report, err
+	}
+
+	replaced := make(map[string]bool)
+	for _, rc := range changes {
+		if rc.Change != nil && rc.Change.Actions.Replace() {
+			replaced[rc.Address] = true
+		}
+	}
+	return assetChanges(before, after, replaced), report, nil
+}
+
+// plannedResourceData drops the deleted resources that have no state after the
+// change, keeping those that are merged into another asset, like IAM members.
+func plannedResourceData(resourceDataMap map[string][]*models.FakeResourceDataWithMeta) map[string][]*models.FakeResourceDataWithMeta {
+	planned := make(map[string][]*models.FakeResourceDataWithMeta, len(resourceDataMap))
+	for address, resourceDataList := range resourceDataMap {
+		for _, rd := range resourceDataList {
+			if rd.IsDeleted() {
+				if converter, ok := converters.ConverterMap[rd.Kind()]; !ok || converter.MergeDelete == nil {
+					continue
+				}
+			}
+			planned[address] = append(planned[address], rd)
+		}
+	}
+	return planned
+}
+
+// assetChanges pairs the assets before and after the change by type and name.
+// Assets that are unchanged, and not replaced, are omitted. The changes are
+// sorted by name.
+func assetChanges(before, after []caiasset.Asset, replaced map[string]bool) []caiasset.AssetChange {
+	beforeAssets := make(map[string]*caiasset.Asset, len(before))
+	for i := range before {
+		beforeAssets[before[i].Type+before[i].Name] = &before[i]
+	}
+
+	var changes []caiasset.AssetChange
+	for i := range after {
+		a := &after[i]
+		change := caiasset.AssetChange{
+			Name:          a.Name,
+			Type:          a.Type,
+			After:         a,
+			TfplanAddress: a.TfplanAddress,
+		}
+
+		b, ok := beforeAssets[a.Type+a.Name]
+		delete(beforeAssets, a.Type+a.Name)
+		if !ok {
+			change.ChangeType = caiasset.ChangeTypeCreate
+			changes = append(changes, change)
+			continue
+		}
+
+		change.Before = b
+		change.TfplanAddress = mergeAddresses(a.TfplanAddress, b.TfplanAddress)
+		switch {
+		case anyReplaced(change.TfplanAddress, replaced):
+			change.ChangeType = caiasset.ChangeTypeReplace
+		case equalAssets(*a, *b):
+			continue
+		default:
+			change.ChangeType = caiasset.ChangeTypeUpdate
+		}
+		changes = append(changes, change)
+	}
+
+	for _, b := range beforeAssets {
+		changes = append(changes, caiasset.AssetChange{
+			Name:          b.Name,
+			Type:          b.Type,
+			ChangeType:    caiasset.ChangeTypeDelete,
+			Before:        b,
+			TfplanAddress: b.TfplanAddress,
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Name == changes[j].Name {
+			return changes[i].Type < changes[j].Type
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+// equalAssets reports whether the assets are equal, ignoring the addresses of
+// the resources they are converted from.
+func equalAssets(a, b caiasset.Asset) bool {
+	a.TfplanAddress = nil
+	b.TfplanAddress = nil
+	return reflect.DeepEqual(a, b)
+}
+
+func anyReplaced(addresses []string, replaced map[string]bool) bool {
+	for _, address := range addresses {
+		if replaced[address] {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeAddresses appends the addresses of b that are not in a.
+func mergeAddresses(a, b []string) []string {
+	merged := append([]string{}, a...)
+	for _, address := range b {
+		if !slices.Contains(merged, address) {
+			merged = append(merged, address)
+		}
+	}
+	return merged
+}
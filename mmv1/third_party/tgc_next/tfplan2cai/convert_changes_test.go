@@ -0,0 +1,142 @@
+package tfplan2cai
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+)
+
+func TestAssetChanges(t *testing.T) {
+	bucket := func(location string, addresses ...string) caiasset.Asset {
+		return caiasset.Asset{
+			Name: "//storage.googleapis.com/my-bucket",
+			Type: "storage.googleapis.com/Bucket",
+			Resource: &caiasset.AssetResource{
+				Data: map[string]interface{}{"location": location},
+			},
+			TfplanAddress: addresses,
+		}
+	}
+	policy := func(members ...string) caiasset.Asset {
+		return caiasset.Asset{
+			Name: "//cloudresourcemanager.googleapis.com/projects/my-project",
+			Type: "cloudresourcemanager.googleapis.com/Project",
+			IAMPolicy: &caiasset.IAMPolicy{
+				Bindings: []caiasset.IAMBinding{{Role: "roles/viewer", Members: members}},
+			},
+			TfplanAddress: []string{"google_project_iam_member.viewer"},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		before   []caiasset.Asset
+		after    []caiasset.Asset
+		replaced map[string]bool
+		want     []caiasset.AssetChange
+	}{
+		{
+			name:  "create",
+			after: []caiasset.Asset{bucket("US", "google_storage_bucket.a")},
+			want: []caiasset.AssetChange{
+				{
+					Name:          "//storage.googleapis.com/my-bucket",
+					Type:          "storage.googleapis.com/Bucket",
+					ChangeType:    caiasset.ChangeTypeCreate,
+					After:         &caiasset.Asset{},
+					TfplanAddress: []string{"google_storage_bucket.a"},
+				},
+			},
+		},
+		{
+			name:   "delete",
+			before: []caiasset.Asset{bucket("US", "google_storage_bucket.a")},
+			want: []caiasset.AssetChange{
+				{
+					Name:          "//storage.googleapis.com/my-bucket",
+					Type:          "storage.googleapis.com/Bucket",
+					ChangeType:    caiasset.ChangeTypeDelete,
+					Before:        &caiasset.Asset{},
+					TfplanAddress: []string{"google_storage_bucket.a"},
+				},
+			},
+		},
+		{
+			name:   "update",
+			before: []caiasset.Asset{bucket("US", "google_storage_bucket.a")},
+			after:  []caiasset.Asset{bucket("EU", "google_storage_bucket.a")},
+			want: []caiasset.AssetChange{
+				{
+					Name:          "//storage.googleapis.com/my-bucket",
+					Type:          "storage.googleapis.com/Bucket",
+					ChangeType:    caiasset.ChangeTypeUpdate,
+					Before:        &caiasset.Asset{},
+					After:         &caiasset.Asset{},
+					TfplanAddress: []string{"google_storage_bucket.a"},
+				},
+			},
+		},
+		{
+			name:   "unchanged",
+			before: []caiasset.Asset{bucket("US", "google_storage_bucket.a")},
+			after:  []caiasset.Asset{bucket("US", "google_storage_bucket.a")},
+		},
+		{
+			name:     "replace",
+			before:   []caiasset.Asset{bucket("US", "google_storage_bucket.a")},
+			after:    []caiasset.Asset{bucket("US", "google_storage_bucket.b")},
+			replaced: map[string]bool{"google_storage_bucket.a": true},
+			want: []caiasset.AssetChange{
+				{
+					Name:          "//storage.googleapis.com/my-bucket",
+					Type:          "storage.googleapis.com/Bucket",
+					ChangeType:    caiasset.ChangeTypeReplace,
+					Before:        &caiasset.Asset{},
+					After:         &caiasset.Asset{},
+					TfplanAddress: []string{"google_storage_bucket.b", "google_storage_bucket.a"},
+				},
+			},
+		},
+		{
+			name:   "removed IAM member",
+			before: []caiasset.Asset{policy("user:a@example.com", "user:b@example.com"), bucket("US", "google_storage_bucket.a")},
+			after:  []caiasset.Asset{policy("user:a@example.com")},
+			want: []caiasset.AssetChange{
+				{
+					Name:          "//cloudresourcemanager.googleapis.com/projects/my-project",
+					Type:          "cloudresourcemanager.googleapis.com/Project",
+					ChangeType:    caiasset.ChangeTypeUpdate,
+					Before:        &caiasset.Asset{},
+					After:         &caiasset.Asset{},
+					TfplanAddress: []string{"google_project_iam_member.viewer"},
+				},
+				{
+					Name:          "//storage.googleapis.com/my-bucket",
+					Type:          "storage.googleapis.com/Bucket",
+					ChangeType:    caiasset.ChangeTypeDelete,
+					Before:        &caiasset.Asset{},
+					TfplanAddress: []string{"google_storage_bucket.a"},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := assetChanges(test.before, test.after, test.replaced)
+			// Only compare whether the before and after assets are set.
+			for i := range got {
+				if got[i].Before != nil {
+					got[i].Before = &caiasset.Asset{}
+				}
+				if got[i].After != nil {
+					got[i].After = &caiasset.Asset{}
+				}
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("assetChanges() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
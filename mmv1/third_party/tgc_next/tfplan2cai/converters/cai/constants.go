@@ -19,3 +19,7 @@ var ErrEmptyIdentityField = errors.New("empty identity field")
 // on a project that has not yet been created or if the service account
 // lacks sufficient permissions
 var ErrResourceInaccessible = errors.New("resource does not exist or service account is lacking sufficient permissions")
+
+// ErrDuplicateAsset can be returned when several resources are converted into
+// the same asset, and the asset cannot be merged.
+var ErrDuplicateAsset = errors.New("duplicate asset")
@@ -0,0 +1,301 @@
+package cai
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeBindings(t *testing.T) {
+	cases := []struct {
+		name string
+		// Inputs
+		existing []caiasset.IAMBinding
+		incoming []caiasset.IAMBinding
+		// Expected outputs
+		expectedAdditive      []caiasset.IAMBinding
+		expectedAuthoritative []caiasset.IAMBinding
+	}{
+		{
+			name:                  "EmptyAddEmpty",
+			existing:              []caiasset.IAMBinding{},
+			incoming:              []caiasset.IAMBinding{},
+			expectedAdditive:      []caiasset.IAMBinding{},
+			expectedAuthoritative: []caiasset.IAMBinding{},
+		},
+		{
+			name:     "EmptyAddOne",
+			existing: []caiasset.IAMBinding{},
+			incoming: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a"},
+				},
+			},
+			expectedAdditive: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a"},
+				},
+			},
+			expectedAuthoritative: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a"},
+				},
+			},
+		},
+		{
+			name: "OneAddEmpty",
+			existing: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a"},
+				},
+			},
+			incoming: []caiasset.IAMBinding{},
+			expectedAdditive: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a"},
+				},
+			},
+			expectedAuthoritative: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a"},
+				},
+			},
+		},
+		{
+			name: "OneAddOne",
+			existing: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a"},
+				},
+			},
+			incoming: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-b"},
+				},
+			},
+			expectedAdditive: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a", "member-b"},
+				},
+			},
+			expectedAuthoritative: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-b"},
+				},
+			},
+		},
+		{
+			name: "GrandFinale",
+			existing: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a", "member-b"},
+				},
+				{
+					Role:    "role-b",
+					Members: []string{"member-c", "member-d"},
+				},
+				{
+					Role:    "role-c",
+					Members: []string{"member-c"},
+				},
+			},
+			incoming: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a", "member-b", "member-c"},
+				},
+				{
+					Role:    "role-b",
+					Members: []string{"member-b", "member-c"},
+				},
+			},
+			expectedAdditive: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a", "member-b", "member-c"},
+				},
+				{
+					Role:    "role-b",
+					Members: []string{"member-b", "member-c", "member-d"},
+				},
+				{
+					Role:    "role-c",
+					Members: []string{"member-c"},
+				},
+			},
+			expectedAuthoritative: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a", "member-b", "member-c"},
+				},
+				{
+					Role:    "role-b",
+					Members: []string{"member-b", "member-c"},
+				},
+				{
+					Role:    "role-c",
+					Members: []string{"member-c"},
+				},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name+"/MergeAdditiveBindings", func(t *testing.T) {
+			assert.EqualValues(t,
+				c.expectedAdditive,
+				MergeAdditiveBindings(c.existing, c.incoming),
+			)
+		})
+		t.Run(c.name+"/MergeAuthoritativeBindings", func(t *testing.T) {
+			assert.EqualValues(t,
+				c.expectedAuthoritative,
+				MergeAuthoritativeBindings(c.existing, c.incoming),
+			)
+		})
+	}
+}
+
+func TestMergeDeleteBindings(t *testing.T) {
+	cases := []struct {
+		name string
+		// Inputs
+		existing []caiasset.IAMBinding
+		incoming []caiasset.IAMBinding
+		// Expected outputs
+		expectedDeleteAdditive      []caiasset.IAMBinding
+		expectedDeleteAuthoritative []caiasset.IAMBinding
+	}{
+		{
+			name:                        "EmptyDeleteEmpty",
+			existing:                    []caiasset.IAMBinding{},
+			incoming:                    []caiasset.IAMBinding{},
+			expectedDeleteAdditive:      nil,
+			expectedDeleteAuthoritative: nil,
+		},
+		{
+			name:     "EmptyDeleteOne",
+			existing: []caiasset.IAMBinding{},
+			incoming: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a"},
+				},
+			},
+			expectedDeleteAdditive:      nil,
+			expectedDeleteAuthoritative: nil,
+		},
+		{
+			name: "OneDeleteEmpty",
+			existing: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a"},
+				},
+			},
+			incoming: []caiasset.IAMBinding{},
+			expectedDeleteAdditive: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a"},
+				},
+			},
+			expectedDeleteAuthoritative: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a"},
+				},
+			},
+		},
+		{
+			name: "OneDeleteOne",
+			existing: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a", "member-b"},
+				},
+			},
+			incoming: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-b"},
+				},
+			},
+			expectedDeleteAdditive: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a"},
+				},
+			},
+			expectedDeleteAuthoritative: nil,
+		},
+		{
+			name: "GrandFinale",
+			existing: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a", "member-b"},
+				},
+				{
+					Role:    "role-b",
+					Members: []string{"member-c", "member-d"},
+				},
+				{
+					Role:    "role-c",
+					Members: []string{"member-c"},
+				},
+			},
+			incoming: []caiasset.IAMBinding{
+				{
+					Role:    "role-a",
+					Members: []string{"member-a", "member-b", "member-c"},
+				},
+				{
+					Role:    "role-b",
+					Members: []string{"member-b", "member-c"},
+				},
+			},
+			expectedDeleteAdditive: []caiasset.IAMBinding{
+				{
+					Role:    "role-b",
+					Members: []string{"member-d"},
+				},
+				{
+					Role:    "role-c",
+					Members: []string{"member-c"},
+				},
+			},
+			expectedDeleteAuthoritative: []caiasset.IAMBinding{
+				{
+					Role:    "role-c",
+					Members: []string{"member-c"},
+				},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name+"/MergeDeleteAdditiveBindings", func(t *testing.T) {
+			assert.EqualValues(t,
+				c.expectedDeleteAdditive,
+				MergeDeleteAdditiveBindings(c.existing, c.incoming),
+			)
+		})
+		t.Run(c.name+"/MergeDeleteAuthoritativeBindings", func(t *testing.T) {
+			assert.EqualValues(t,
+				c.expectedDeleteAuthoritative,
+				MergeDeleteAuthoritativeBindings(c.existing, c.incoming),
+			)
+		})
+	}
+}
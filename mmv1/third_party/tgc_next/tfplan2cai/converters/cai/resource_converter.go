@@ -14,7 +14,14 @@ type ConvertFunc func(d tpgresource.TerraformResourceData, config *transport_tpg
 // by Terraform, like IAM policies managed with member/binding resources.
 type FetchFullResourceFunc func(d tpgresource.TerraformResourceData, config *transport_tpg.Config) (caiasset.Asset, error)
 
+// MergeFunc merges an incoming asset into an existing asset with the same
+// type and name, such as the IAM policy of a resource changed by several
+// _iam_member and _iam_binding resources.
+type MergeFunc func(existing, incoming caiasset.Asset) caiasset.Asset
+
 type ResourceConverter struct {
 	Convert           ConvertFunc
 	FetchFullResource FetchFullResourceFunc
+	MergeCreateUpdate MergeFunc
+	MergeDelete       MergeFunc
 }
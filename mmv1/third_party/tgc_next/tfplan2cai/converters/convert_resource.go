@@ -3,8 +3,6 @@ package converters
 import (
 	"fmt"
 
-	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
-	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/ancestrymanager"
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/converters/cai"
 	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/models"
 
@@ -14,40 +12,55 @@ import (
 	"go.uber.org/zap"
 )
 
-// Converts the single resource into CAI assets
-func ConvertResource(rdList []*models.FakeResourceDataWithMeta, cfg *transport_tpg.Config, am ancestrymanager.AncestryManager, errLogger *zap.Logger) ([]caiasset.Asset, error) {
+// Converts the single resource into CAI assets and adds them to merger,
+// recording the result for each resource data in report. If failFast is set,
+// the first failed conversion is returned as an error.
+func ConvertResource(rdList []*models.FakeResourceDataWithMeta, cfg *transport_tpg.Config, merger *AssetMerger, errLogger *zap.Logger, report *models.ConversionReport, failFast bool) error {
 	if rdList == nil || len(rdList) == 0 {
-		return nil, nil
+		return nil
 	}
 
-	var assets []caiasset.Asset
 	for _, rd := range rdList {
 		// Skip unsupported resources
 		converter, ok := ConverterMap[rd.Kind()]
 		if !ok {
 			errLogger.Debug(fmt.Sprintf("%s: resource type cannot be converted for CAI-based policies: %s. For details, see https://cloud.google.com/docs/terraform/policy-validation/create-cai-constraints#supported_resources", rd.Address(), rd.Kind()))
+			report.Add(rd, models.ConversionUnsupported, nil)
 			continue
-		} else {
-			convertedAssets, err := converter.Convert(rd, cfg)
-			if err != nil {
-				if errors.Cause(err) == cai.ErrNoConversion {
-					continue
-				}
-			}
+		}
 
-			// TODO: combine assets and fetch full policy for IAM bindings/members
-			// TODO: combine tfplan address
+		convertedAssets, err := converter.Convert(rd, cfg)
+		if errors.Cause(err) == cai.ErrNoConversion {
+			report.Add(rd, models.ConversionSkipped, nil)
+			continue
+		}
 
+		added := false
+		if err == nil {
 			for _, asset := range convertedAssets {
-				asset.TfplanAddress = []string{rd.Address()}
-				err := am.SetAncestors(rd, cfg, &asset)
-				if err != nil {
-					return nil, err
+				var ok bool
+				if ok, err = merger.Add(rd, converter, asset); err != nil {
+					break
 				}
-				assets = append(assets, asset)
+				added = added || ok
 			}
 		}
+		if err != nil {
+			err = fmt.Errorf("%s: %w", rd.Address(), err)
+			report.Add(rd, models.ConversionFailed, err)
+			if failFast {
+				return err
+			}
+			errLogger.Warn(err.Error())
+			continue
+		}
+
+		if added {
+			report.Add(rd, models.ConversionConverted, nil)
+		} else {
+			report.Add(rd, models.ConversionSkipped, nil)
+		}
 	}
 
-	return assets, nil
+	return nil
 }
@@ -0,0 +1,141 @@
+package converters
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/ancestrymanager"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/converters/cai"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/models"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// AssetMerger collects the converted assets, merging assets with the same type
+// and name. This combines the changes of _iam_policy, _iam_binding and
+// _iam_member resources for the same resource into a single IAM policy asset,
+// merged with the existing policy when it is available.
+type AssetMerger struct {
+	cfg             *transport_tpg.Config
+	ancestryManager ancestrymanager.AncestryManager
+	offline         bool
+
+	// Map asset names to their existing IAM policy, used instead of fetching
+	// the policy
+	iamPolicyCache map[string]*caiasset.IAMPolicy
+
+	// For logging error / status information that doesn't warrant an outright failure
+	errorLogger *zap.Logger
+
+	// Map of merged assets (key = asset.Type + asset.Name)
+	assets map[string]caiasset.Asset
+}
+
+func NewAssetMerger(cfg *transport_tpg.Config, ancestryManager ancestrymanager.AncestryManager, offline bool, iamPolicyCache map[string]*caiasset.IAMPolicy, errorLogger *zap.Logger) *AssetMerger {
+	return &AssetMerger{
+		cfg:             cfg,
+		ancestryManager: ancestryManager,
+		offline:         offline,
+		iamPolicyCache:  iamPolicyCache,
+		errorLogger:     errorLogger,
+		assets:          make(map[string]caiasset.Asset),
+	}
+}
+
+// Add merges an asset converted from rd into the collected assets, recording
+// the address of rd in the asset. Returns false if the asset was dropped, such
+// as a deleted IAM member of a policy that is not known.
+func (m *AssetMerger) Add(rd *models.FakeResourceDataWithMeta, converter cai.ResourceConverter, asset caiasset.Asset) (bool, error) {
+	key := asset.Type + asset.Name
+	existing, exists := m.assets[key]
+	if !exists {
+		var err error
+		existing, exists, err = m.fetchExisting(rd, converter, asset)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	merged := asset
+	switch {
+	case rd.IsDeleted() && converter.MergeDelete != nil:
+		if !exists {
+			return false, nil
+		}
+		merged = converter.MergeDelete(existing, asset)
+	case exists:
+		if converter.MergeCreateUpdate == nil {
+			return false, fmt.Errorf("%w: type %s: name %s", cai.ErrDuplicateAsset, asset.Type, asset.Name)
+		}
+		merged = converter.MergeCreateUpdate(existing, asset)
+	}
+
+	merged.TfplanAddress = append(slices.Clone(existing.TfplanAddress), rd.Address())
+	if err := m.ancestryManager.SetAncestors(rd, m.cfg, &merged); err != nil {
+		return false, err
+	}
+	m.assets[key] = merged
+	return true, nil
+}
+
+// fetchExisting returns the existing state of an asset that planned changes
+// are merged with, from the IAM policy cache or from the API if online.
+func (m *AssetMerger) fetchExisting(rd *models.FakeResourceDataWithMeta, converter cai.ResourceConverter, asset caiasset.Asset) (caiasset.Asset, bool, error) {
+	if converter.FetchFullResource == nil {
+		return caiasset.Asset{}, false, nil
+	}
+
+	if policy, ok := m.iamPolicyCache[asset.Name]; ok && policy != nil {
+		// Copy the bindings, as merging modifies them.
+		bindings := make([]caiasset.IAMBinding, 0, len(policy.Bindings))
+		for _, b := range policy.Bindings {
+			bindings = append(bindings, caiasset.IAMBinding{
+				Role:    b.Role,
+				Members: slices.Clone(b.Members),
+			})
+		}
+		return caiasset.Asset{
+			Name:      asset.Name,
+			Type:      asset.Type,
+			IAMPolicy: &caiasset.IAMPolicy{Bindings: bindings},
+		}, true, nil
+	}
+
+	if m.offline {
+		return caiasset.Asset{}, false, nil
+	}
+
+	existing, err := converter.FetchFullResource(rd, m.cfg)
+	switch errors.Cause(err) {
+	case nil:
+		return existing, true, nil
+	case cai.ErrEmptyIdentityField:
+		m.errorLogger.Debug(fmt.Sprintf("%s: Unable to fetch and merge remote %s asset due to unset or (known after apply) identity fields on the TF resource.", rd.Address(), asset.Type))
+		return caiasset.Asset{}, false, nil
+	case cai.ErrResourceInaccessible:
+		m.errorLogger.Warn(fmt.Sprintf("%s: Fetching %s for merge failed due to not existing or insufficient permission.", rd.Address(), asset.Type+asset.Name))
+		return caiasset.Asset{}, false, nil
+	default:
+		return caiasset.Asset{}, false, fmt.Errorf("fetching remote asset %s: %w", asset.Type+asset.Name, err)
+	}
+}
+
+// Assets lists the merged assets, sorted by name.
+func (m *AssetMerger) Assets() []caiasset.Asset {
+	assets := make([]caiasset.Asset, 0, len(m.assets))
+	for _, asset := range m.assets {
+		assets = append(assets, asset)
+	}
+	sort.Slice(assets, func(i, j int) bool {
+		if assets[i].Name == assets[j].Name {
+			return assets[i].Type < assets[j].Type
+		}
+		return assets[i].Name < assets[j].Name
+	})
+	return assets
+}
@@ -0,0 +1,60 @@
+package orgpolicy
+
+import (
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/converters/cai"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+const OrgPolicyCustomConstraintAssetType string = "orgpolicy.googleapis.com/CustomConstraint"
+
+func ResourceConverterOrgPolicyCustomConstraint() cai.ResourceConverter {
+	return cai.ResourceConverter{
+		Convert: GetOrgPolicyCustomConstraintCaiObject,
+	}
+}
+
+func GetOrgPolicyCustomConstraintCaiObject(d tpgresource.TerraformResourceData, config *transport_tpg.Config) ([]caiasset.Asset, error) {
+	name, err := cai.AssetName(d, config, "//orgpolicy.googleapis.com/{{parent}}/customConstraints/{{name}}")
+	if err != nil {
+		return []caiasset.Asset{}, err
+	}
+	if data, err := GetOrgPolicyCustomConstraintData(d, config); err == nil {
+		return []caiasset.Asset{{
+			Name: name,
+			Type: OrgPolicyCustomConstraintAssetType,
+			Resource: &caiasset.AssetResource{
+				Version:              "v2",
+				DiscoveryDocumentURI: "https://orgpolicy.googleapis.com/$discovery/rest?version=v2",
+				DiscoveryName:        "CustomConstraint",
+				Data:                 data,
+			},
+		}}, nil
+	} else {
+		return []caiasset.Asset{}, err
+	}
+}
+
+func GetOrgPolicyCustomConstraintData(d tpgresource.TerraformResourceData, config *transport_tpg.Config) (map[string]interface{}, error) {
+	name, err := tpgresource.ReplaceVars(d, config, "{{parent}}/customConstraints/{{name}}")
+	if err != nil {
+		return nil, err
+	}
+
+	obj := map[string]interface{}{
+		"name":          name,
+		"condition":     d.Get("condition"),
+		"actionType":    d.Get("action_type"),
+		"methodTypes":   d.Get("method_types"),
+		"resourceTypes": d.Get("resource_types"),
+	}
+	if v, ok := d.GetOk("display_name"); ok {
+		obj["displayName"] = v
+	}
+	if v, ok := d.GetOk("description"); ok {
+		obj["description"] = v
+	}
+	return obj, nil
+}
@@ -0,0 +1,54 @@
+package orgpolicy
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/models"
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/hashicorp/terraform-provider-google-beta/google-beta/provider"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestGetOrgPolicyCustomConstraintCaiObject(t *testing.T) {
+	p := provider.Provider()
+	d := models.NewFakeResourceDataWithMeta(
+		"google_org_policy_custom_constraint",
+		p.ResourcesMap["google_org_policy_custom_constraint"].Schema,
+		map[string]interface{}{
+			"name":           "custom.disableGkeAutoUpgrade",
+			"parent":         "organizations/654321",
+			"display_name":   "Disable GKE auto upgrade",
+			"condition":      "resource.management.autoUpgrade == false",
+			"action_type":    "ALLOW",
+			"method_types":   []interface{}{"CREATE", "UPDATE"},
+			"resource_types": []interface{}{"container.googleapis.com/NodePool"},
+		},
+		false,
+		"google_org_policy_custom_constraint.constraint",
+	)
+
+	assets, err := GetOrgPolicyCustomConstraintCaiObject(d, &transport_tpg.Config{})
+	if err != nil {
+		t.Fatalf("converting google_org_policy_custom_constraint: %s", err)
+	}
+	want := []caiasset.Asset{{
+		Name: "//orgpolicy.googleapis.com/organizations/654321/customConstraints/custom.disableGkeAutoUpgrade",
+		Type: OrgPolicyCustomConstraintAssetType,
+		Resource: &caiasset.AssetResource{
+			Version:              "v2",
+			DiscoveryDocumentURI: "https://orgpolicy.googleapis.com/$discovery/rest?version=v2",
+			DiscoveryName:        "CustomConstraint",
+			Data: map[string]interface{}{
+				"name":          "organizations/654321/customConstraints/custom.disableGkeAutoUpgrade",
+				"displayName":   "Disable GKE auto upgrade",
+				"condition":     "resource.management.autoUpgrade == false",
+				"actionType":    "ALLOW",
+				"methodTypes":   []interface{}{"CREATE", "UPDATE"},
+				"resourceTypes": []interface{}{"container.googleapis.com/NodePool"},
+			},
+		},
+	}}
+	assert.Equal(t, want, assets)
+}
@@ -0,0 +1,35 @@
+package resourcemanager
+
+import (
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/converters/cai"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func ResourceConverterFolderOrgPolicy() cai.ResourceConverter {
+	return cai.ResourceConverter{
+		Convert:           GetFolderOrgPolicyCaiObject,
+		MergeCreateUpdate: MergeOrgPolicy,
+	}
+}
+
+func GetFolderOrgPolicyCaiObject(d tpgresource.TerraformResourceData, config *transport_tpg.Config) ([]caiasset.Asset, error) {
+	folder := d.Get("folder").(string)
+	if !strings.HasPrefix(folder, "folders/") {
+		folder = "folders/" + folder
+	}
+	name := "//cloudresourcemanager.googleapis.com/" + folder
+	if obj, err := GetOrgPolicyApiObject(d, config); err == nil {
+		return []caiasset.Asset{{
+			Name:      name,
+			Type:      "cloudresourcemanager.googleapis.com/Folder",
+			OrgPolicy: []*caiasset.OrgPolicy{&obj},
+		}}, nil
+	} else {
+		return []caiasset.Asset{}, err
+	}
+}
@@ -0,0 +1,182 @@
+package resourcemanager
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/models"
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/hashicorp/terraform-provider-google-beta/google-beta/provider"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestGetV2OrgPoliciesCaiObject(t *testing.T) {
+	p := provider.Provider()
+	cases := []struct {
+		name       string
+		values     map[string]interface{}
+		wantName   string
+		wantType   string
+		wantPolicy caiasset.V2OrgPolicies
+	}{
+		{
+			name: "project boolean policy",
+			values: map[string]interface{}{
+				"name":   "projects/my-project/policies/compute.skipDefaultNetworkCreation",
+				"parent": "projects/my-project",
+				"spec": []interface{}{
+					map[string]interface{}{
+						"rules": []interface{}{
+							map[string]interface{}{"enforce": "TRUE"},
+						},
+					},
+				},
+			},
+			wantName: "//cloudresourcemanager.googleapis.com/projects/my-project",
+			wantType: "cloudresourcemanager.googleapis.com/Project",
+			wantPolicy: caiasset.V2OrgPolicies{
+				Name: "projects/my-project/policies/compute.skipDefaultNetworkCreation",
+				PolicySpec: &caiasset.PolicySpec{
+					PolicyRules: []*caiasset.PolicyRule{
+						{Enforce: true},
+					},
+				},
+			},
+		},
+		{
+			name: "folder list policy",
+			values: map[string]interface{}{
+				"name":   "folders/123456/policies/gcp.resourceLocations",
+				"parent": "folders/123456",
+				"spec": []interface{}{
+					map[string]interface{}{
+						"inherit_from_parent": true,
+						"rules": []interface{}{
+							map[string]interface{}{
+								"values": []interface{}{
+									map[string]interface{}{
+										"allowed_values": []interface{}{"in:us-locations"},
+										"denied_values":  []interface{}{"in:eu-locations"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantName: "//cloudresourcemanager.googleapis.com/folders/123456",
+			wantType: "cloudresourcemanager.googleapis.com/Folder",
+			wantPolicy: caiasset.V2OrgPolicies{
+				Name: "folders/123456/policies/gcp.resourceLocations",
+				PolicySpec: &caiasset.PolicySpec{
+					InheritFromParent: true,
+					PolicyRules: []*caiasset.PolicyRule{
+						{
+							Values: &caiasset.StringValues{
+								AllowedValues: []string{"in:us-locations"},
+								DeniedValues:  []string{"in:eu-locations"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "organization conditional policy",
+			values: map[string]interface{}{
+				"name":   "organizations/654321/policies/compute.requireOsLogin",
+				"parent": "organizations/654321",
+				"spec": []interface{}{
+					map[string]interface{}{
+						"rules": []interface{}{
+							map[string]interface{}{
+								"enforce": "FALSE",
+								"condition": []interface{}{
+									map[string]interface{}{
+										"expression": "resource.matchTag('123/env', 'dev')",
+										"title":      "dev",
+									},
+								},
+							},
+							map[string]interface{}{"allow_all": "TRUE"},
+						},
+					},
+				},
+			},
+			wantName: "//cloudresourcemanager.googleapis.com/organizations/654321",
+			wantType: "cloudresourcemanager.googleapis.com/Organization",
+			wantPolicy: caiasset.V2OrgPolicies{
+				Name: "organizations/654321/policies/compute.requireOsLogin",
+				PolicySpec: &caiasset.PolicySpec{
+					PolicyRules: []*caiasset.PolicyRule{
+						{
+							Condition: &caiasset.Expr{
+								Expression: "resource.matchTag('123/env', 'dev')",
+								Title:      "dev",
+							},
+						},
+						{AllowAll: true},
+					},
+				},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := models.NewFakeResourceDataWithMeta("google_org_policy_policy", p.ResourcesMap["google_org_policy_policy"].Schema, c.values, false, "google_org_policy_policy.policy")
+
+			assets, err := GetV2OrgPoliciesCaiObject(d, &transport_tpg.Config{})
+			if err != nil {
+				t.Fatalf("converting google_org_policy_policy: %s", err)
+			}
+			want := []caiasset.Asset{{
+				Name:          c.wantName,
+				Type:          c.wantType,
+				V2OrgPolicies: []*caiasset.V2OrgPolicies{&c.wantPolicy},
+			}}
+			assert.Equal(t, want, assets)
+		})
+	}
+}
+
+func TestGetV2OrgPoliciesCaiObject_invalid(t *testing.T) {
+	p := provider.Provider()
+	cases := []struct {
+		name    string
+		values  map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "invalid parent",
+			values: map[string]interface{}{
+				"name":   "billingAccounts/123/policies/compute.requireOsLogin",
+				"parent": "billingAccounts/123",
+			},
+			wantErr: "Invalid parent address(billingAccounts/123)",
+		},
+		{
+			name: "invalid boolean",
+			values: map[string]interface{}{
+				"name":   "projects/my-project/policies/compute.requireOsLogin",
+				"parent": "projects/my-project",
+				"spec": []interface{}{
+					map[string]interface{}{
+						"rules": []interface{}{
+							map[string]interface{}{"enforce": "yes"},
+						},
+					},
+				},
+			},
+			wantErr: "Invalid value for a boolean field: yes",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := models.NewFakeResourceDataWithMeta("google_org_policy_policy", p.ResourcesMap["google_org_policy_policy"].Schema, c.values, false, "google_org_policy_policy.policy")
+
+			_, err := GetV2OrgPoliciesCaiObject(d, &transport_tpg.Config{})
+			assert.ErrorContains(t, err, c.wantErr)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package resourcemanager
+
+import (
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/converters/cai"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func ResourceConverterOrganizationPolicy() cai.ResourceConverter {
+	return cai.ResourceConverter{
+		Convert:           GetOrganizationPolicyCaiObject,
+		MergeCreateUpdate: MergeOrgPolicy,
+	}
+}
+
+func GetOrganizationPolicyCaiObject(d tpgresource.TerraformResourceData, config *transport_tpg.Config) ([]caiasset.Asset, error) {
+	name, err := cai.AssetName(d, config, "//cloudresourcemanager.googleapis.com/organizations/{{org_id}}")
+	if err != nil {
+		return []caiasset.Asset{}, err
+	}
+	if obj, err := GetOrgPolicyApiObject(d, config); err == nil {
+		return []caiasset.Asset{{
+			Name:      name,
+			Type:      "cloudresourcemanager.googleapis.com/Organization",
+			OrgPolicy: []*caiasset.OrgPolicy{&obj},
+		}}, nil
+	} else {
+		return []caiasset.Asset{}, err
+	}
+}
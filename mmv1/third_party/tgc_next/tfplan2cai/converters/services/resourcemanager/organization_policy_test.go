@@ -0,0 +1,209 @@
+package resourcemanager
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/models"
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/hashicorp/terraform-provider-google-beta/google-beta/provider"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestGetOrgPolicyCaiObject(t *testing.T) {
+	p := provider.Provider()
+	cases := []struct {
+		name       string
+		kind       string
+		values     map[string]interface{}
+		convert    func(*models.FakeResourceDataWithMeta, *transport_tpg.Config) ([]caiasset.Asset, error)
+		wantName   string
+		wantType   string
+		wantPolicy caiasset.OrgPolicy
+	}{
+		{
+			name: "project boolean policy",
+			kind: "google_project_organization_policy",
+			values: map[string]interface{}{
+				"project":    "my-project",
+				"constraint": "compute.skipDefaultNetworkCreation",
+				"boolean_policy": []interface{}{
+					map[string]interface{}{"enforced": true},
+				},
+			},
+			convert: func(d *models.FakeResourceDataWithMeta, config *transport_tpg.Config) ([]caiasset.Asset, error) {
+				return GetProjectOrgPolicyCaiObject(d, config)
+			},
+			wantName: "//cloudresourcemanager.googleapis.com/projects/my-project",
+			wantType: "cloudresourcemanager.googleapis.com/Project",
+			wantPolicy: caiasset.OrgPolicy{
+				Constraint:    "constraints/compute.skipDefaultNetworkCreation",
+				BooleanPolicy: &caiasset.BooleanPolicy{Enforced: true},
+			},
+		},
+		{
+			name: "folder list policy allowing all values",
+			kind: "google_folder_organization_policy",
+			values: map[string]interface{}{
+				"folder":     "123456",
+				"constraint": "constraints/serviceuser.services",
+				"list_policy": []interface{}{
+					map[string]interface{}{
+						"allow": []interface{}{
+							map[string]interface{}{"all": true},
+						},
+					},
+				},
+			},
+			convert: func(d *models.FakeResourceDataWithMeta, config *transport_tpg.Config) ([]caiasset.Asset, error) {
+				return GetFolderOrgPolicyCaiObject(d, config)
+			},
+			wantName: "//cloudresourcemanager.googleapis.com/folders/123456",
+			wantType: "cloudresourcemanager.googleapis.com/Folder",
+			wantPolicy: caiasset.OrgPolicy{
+				Constraint: "constraints/serviceuser.services",
+				ListPolicy: &caiasset.ListPolicy{
+					AllValues: caiasset.ListPolicyAllValuesAllow,
+				},
+			},
+		},
+		{
+			name: "folder list policy denying values",
+			kind: "google_folder_organization_policy",
+			values: map[string]interface{}{
+				"folder":     "folders/123456",
+				"constraint": "serviceuser.services",
+				"list_policy": []interface{}{
+					map[string]interface{}{
+						"deny": []interface{}{
+							map[string]interface{}{
+								"values": []interface{}{"cloudresourcemanager.googleapis.com"},
+							},
+						},
+						"inherit_from_parent": true,
+					},
+				},
+			},
+			convert: func(d *models.FakeResourceDataWithMeta, config *transport_tpg.Config) ([]caiasset.Asset, error) {
+				return GetFolderOrgPolicyCaiObject(d, config)
+			},
+			wantName: "//cloudresourcemanager.googleapis.com/folders/123456",
+			wantType: "cloudresourcemanager.googleapis.com/Folder",
+			wantPolicy: caiasset.OrgPolicy{
+				Constraint: "constraints/serviceuser.services",
+				ListPolicy: &caiasset.ListPolicy{
+					AllValues:         caiasset.ListPolicyAllValuesUnspecified,
+					DeniedValues:      []string{"cloudresourcemanager.googleapis.com"},
+					InheritFromParent: true,
+				},
+			},
+		},
+		{
+			name: "organization list policy allowing values",
+			kind: "google_organization_policy",
+			values: map[string]interface{}{
+				"org_id":     "654321",
+				"constraint": "compute.vmExternalIpAccess",
+				"list_policy": []interface{}{
+					map[string]interface{}{
+						"allow": []interface{}{
+							map[string]interface{}{
+								"values": []interface{}{"projects/my-project/zones/us-central1-a/instances/my-instance"},
+							},
+						},
+						"suggested_value": "projects/my-project/zones/us-central1-a/instances/my-instance",
+					},
+				},
+			},
+			convert: func(d *models.FakeResourceDataWithMeta, config *transport_tpg.Config) ([]caiasset.Asset, error) {
+				return GetOrganizationPolicyCaiObject(d, config)
+			},
+			wantName: "//cloudresourcemanager.googleapis.com/organizations/654321",
+			wantType: "cloudresourcemanager.googleapis.com/Organization",
+			wantPolicy: caiasset.OrgPolicy{
+				Constraint: "constraints/compute.vmExternalIpAccess",
+				ListPolicy: &caiasset.ListPolicy{
+					AllValues:      caiasset.ListPolicyAllValuesUnspecified,
+					AllowedValues:  []string{"projects/my-project/zones/us-central1-a/instances/my-instance"},
+					SuggestedValue: "projects/my-project/zones/us-central1-a/instances/my-instance",
+				},
+			},
+		},
+		{
+			name: "organization restore policy",
+			kind: "google_organization_policy",
+			values: map[string]interface{}{
+				"org_id":     "654321",
+				"constraint": "iam.disableServiceAccountKeyCreation",
+				"restore_policy": []interface{}{
+					map[string]interface{}{"default": true},
+				},
+			},
+			convert: func(d *models.FakeResourceDataWithMeta, config *transport_tpg.Config) ([]caiasset.Asset, error) {
+				return GetOrganizationPolicyCaiObject(d, config)
+			},
+			wantName: "//cloudresourcemanager.googleapis.com/organizations/654321",
+			wantType: "cloudresourcemanager.googleapis.com/Organization",
+			wantPolicy: caiasset.OrgPolicy{
+				Constraint:     "constraints/iam.disableServiceAccountKeyCreation",
+				RestoreDefault: &caiasset.RestoreDefault{},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := models.NewFakeResourceDataWithMeta(c.kind, p.ResourcesMap[c.kind].Schema, c.values, false, c.kind+".policy")
+
+			assets, err := c.convert(d, &transport_tpg.Config{})
+			if err != nil {
+				t.Fatalf("converting %s: %s", c.kind, err)
+			}
+			want := []caiasset.Asset{{
+				Name:      c.wantName,
+				Type:      c.wantType,
+				OrgPolicy: []*caiasset.OrgPolicy{&c.wantPolicy},
+			}}
+			assert.Equal(t, want, assets)
+		})
+	}
+}
+
+func TestGetOrgPolicyCaiObject_invalidRestorePolicy(t *testing.T) {
+	p := provider.Provider()
+	kind := "google_project_organization_policy"
+	d := models.NewFakeResourceDataWithMeta(kind, p.ResourcesMap[kind].Schema, map[string]interface{}{
+		"project":    "my-project",
+		"constraint": "compute.skipDefaultNetworkCreation",
+		"restore_policy": []interface{}{
+			map[string]interface{}{"default": false},
+		},
+	}, false, kind+".policy")
+
+	_, err := GetProjectOrgPolicyCaiObject(d, &transport_tpg.Config{})
+	assert.ErrorContains(t, err, "Expecting default = true")
+}
+
+func TestMergeOrgPolicy(t *testing.T) {
+	existing := caiasset.Asset{
+		Name: "//cloudresourcemanager.googleapis.com/projects/my-project",
+		Type: "cloudresourcemanager.googleapis.com/Project",
+		Resource: &caiasset.AssetResource{
+			Data: map[string]interface{}{"projectId": "my-project"},
+		},
+		OrgPolicy: []*caiasset.OrgPolicy{
+			{Constraint: "constraints/compute.skipDefaultNetworkCreation", BooleanPolicy: &caiasset.BooleanPolicy{Enforced: true}},
+		},
+	}
+	incoming := caiasset.Asset{
+		Name: "//cloudresourcemanager.googleapis.com/projects/my-project",
+		Type: "cloudresourcemanager.googleapis.com/Project",
+		OrgPolicy: []*caiasset.OrgPolicy{
+			{Constraint: "constraints/serviceuser.services", ListPolicy: &caiasset.ListPolicy{AllValues: caiasset.ListPolicyAllValuesDeny}},
+		},
+	}
+
+	merged := MergeOrgPolicy(existing, incoming)
+	assert.Equal(t, existing.Resource, merged.Resource)
+	assert.Equal(t, append(existing.OrgPolicy, incoming.OrgPolicy...), merged.OrgPolicy)
+}
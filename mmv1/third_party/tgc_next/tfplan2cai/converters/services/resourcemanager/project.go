@@ -17,7 +17,8 @@ import (
 
 func ResourceConverterProject() cai.ResourceConverter {
 	return cai.ResourceConverter{
-		Convert: GetProjectAndBillingInfoCaiObjects,
+		Convert:           GetProjectAndBillingInfoCaiObjects,
+		MergeCreateUpdate: MergeProject,
 	}
 }
 
@@ -147,3 +148,8 @@ func GetProjectBillingInfoData(d tpgresource.TerraformResourceData, project stri
 
 	return cai.JsonMap(ba)
 }
+
+func MergeProject(existing, incoming caiasset.Asset) caiasset.Asset {
+	existing.Resource = incoming.Resource
+	return existing
+}
@@ -0,0 +1,111 @@
+package resourcemanager
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/converters/cai"
+
+	tpgresourcemanager "github.com/hashicorp/terraform-provider-google-beta/google-beta/services/resourcemanager"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func ResourceConverterProjectIamPolicy() cai.ResourceConverter {
+	return cai.ResourceConverter{
+		Convert:           GetProjectIamPolicyCaiObject,
+		MergeCreateUpdate: MergeProjectIamPolicy,
+	}
+}
+
+func ResourceConverterProjectIamBinding() cai.ResourceConverter {
+	return cai.ResourceConverter{
+		Convert:           GetProjectIamBindingCaiObject,
+		FetchFullResource: FetchProjectIamPolicy,
+		MergeCreateUpdate: MergeProjectIamBinding,
+		MergeDelete:       MergeProjectIamBindingDelete,
+	}
+}
+
+func ResourceConverterProjectIamMember() cai.ResourceConverter {
+	return cai.ResourceConverter{
+		Convert:           GetProjectIamMemberCaiObject,
+		FetchFullResource: FetchProjectIamPolicy,
+		MergeCreateUpdate: MergeProjectIamMember,
+		MergeDelete:       MergeProjectIamMemberDelete,
+	}
+}
+
+func GetProjectIamPolicyCaiObject(d tpgresource.TerraformResourceData, config *transport_tpg.Config) ([]caiasset.Asset, error) {
+	return newProjectIamAsset(d, config, cai.ExpandIamPolicyBindings)
+}
+
+func GetProjectIamBindingCaiObject(d tpgresource.TerraformResourceData, config *transport_tpg.Config) ([]caiasset.Asset, error) {
+	return newProjectIamAsset(d, config, cai.ExpandIamRoleBindings)
+}
+
+func GetProjectIamMemberCaiObject(d tpgresource.TerraformResourceData, config *transport_tpg.Config) ([]caiasset.Asset, error) {
+	return newProjectIamAsset(d, config, cai.ExpandIamMemberBindings)
+}
+
+func MergeProjectIamPolicy(existing, incoming caiasset.Asset) caiasset.Asset {
+	existing.IAMPolicy = incoming.IAMPolicy
+	return existing
+}
+
+func MergeProjectIamBinding(existing, incoming caiasset.Asset) caiasset.Asset {
+	return cai.MergeIamAssets(existing, incoming, cai.MergeAuthoritativeBindings)
+}
+
+func MergeProjectIamBindingDelete(existing, incoming caiasset.Asset) caiasset.Asset {
+	return cai.MergeDeleteIamAssets(existing, incoming, cai.MergeDeleteAuthoritativeBindings)
+}
+
+func MergeProjectIamMember(existing, incoming caiasset.Asset) caiasset.Asset {
+	return cai.MergeIamAssets(existing, incoming, cai.MergeAdditiveBindings)
+}
+
+func MergeProjectIamMemberDelete(existing, incoming caiasset.Asset) caiasset.Asset {
+	return cai.MergeDeleteIamAssets(existing, incoming, cai.MergeDeleteAdditiveBindings)
+}
+
+func newProjectIamAsset(
+	d tpgresource.TerraformResourceData,
+	config *transport_tpg.Config,
+	expandBindings func(d tpgresource.TerraformResourceData) ([]caiasset.IAMBinding, error),
+) ([]caiasset.Asset, error) {
+	bindings, err := expandBindings(d)
+	if err != nil {
+		return []caiasset.Asset{}, fmt.Errorf("expanding bindings: %v", err)
+	}
+
+	// Ideally we should use project_number, but since that is generated server-side,
+	// we substitute project_id.
+	name, err := cai.AssetName(d, config, "//cloudresourcemanager.googleapis.com/projects/{{project}}")
+	if err != nil {
+		return []caiasset.Asset{}, err
+	}
+
+	return []caiasset.Asset{{
+		Name: name,
+		Type: "cloudresourcemanager.googleapis.com/Project",
+		IAMPolicy: &caiasset.IAMPolicy{
+			Bindings: bindings,
+		},
+	}}, nil
+}
+
+func FetchProjectIamPolicy(d tpgresource.TerraformResourceData, config *transport_tpg.Config) (caiasset.Asset, error) {
+	if _, ok := d.GetOk("project"); !ok {
+		return caiasset.Asset{}, cai.ErrEmptyIdentityField
+	}
+
+	// We use project_id in the asset name template to be consistent with newProjectIamAsset.
+	return cai.FetchIamPolicy(
+		tpgresourcemanager.NewProjectIamUpdater,
+		d,
+		config,
+		"//cloudresourcemanager.googleapis.com/projects/{{project}}",
+		"cloudresourcemanager.googleapis.com/Project",
+	)
+}
@@ -0,0 +1,146 @@
+package resourcemanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/caiasset"
+	"github.com/GoogleCloudPlatform/terraform-google-conversion/v6/pkg/tfplan2cai/converters/cai"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func ResourceConverterProjectOrgPolicy() cai.ResourceConverter {
+	return cai.ResourceConverter{
+		Convert:           GetProjectOrgPolicyCaiObject,
+		MergeCreateUpdate: MergeOrgPolicy,
+	}
+}
+
+func GetProjectOrgPolicyCaiObject(d tpgresource.TerraformResourceData, config *transport_tpg.Config) ([]caiasset.Asset, error) {
+	name, err := cai.AssetName(d, config, "//cloudresourcemanager.googleapis.com/projects/{{project}}")
+	if err != nil {
+		return []caiasset.Asset{}, err
+	}
+	if obj, err := GetOrgPolicyApiObject(d, config); err == nil {
+		return []caiasset.Asset{{
+			Name:      name,
+			Type:      "cloudresourcemanager.googleapis.com/Project",
+			OrgPolicy: []*caiasset.OrgPolicy{&obj},
+		}}, nil
+	} else {
+		return []caiasset.Asset{}, err
+	}
+}
+
+// MergeOrgPolicy merges the organization policies of a resource into the
+// asset of the resource they are set on.
+func MergeOrgPolicy(existing, incoming caiasset.Asset) caiasset.Asset {
+	existing.OrgPolicy = append(existing.OrgPolicy, incoming.OrgPolicy...)
+	return existing
+}
+
+// GetOrgPolicyApiObject expands the organization policy of a
+// google_project_organization_policy, google_folder_organization_policy or
+// google_organization_policy resource.
+func GetOrgPolicyApiObject(d tpgresource.TerraformResourceData, config *transport_tpg.Config) (caiasset.OrgPolicy, error) {
+	listPolicy, err := expandListOrganizationPolicy(d.Get("list_policy").([]interface{}))
+	if err != nil {
+		return caiasset.OrgPolicy{}, err
+	}
+
+	restoreDefault, err := expandRestoreOrganizationPolicy(d.Get("restore_policy").([]interface{}))
+	if err != nil {
+		return caiasset.OrgPolicy{}, err
+	}
+
+	policy := caiasset.OrgPolicy{
+		Constraint:     CanonicalOrgPolicyConstraint(d.Get("constraint").(string)),
+		BooleanPolicy:  expandBooleanOrganizationPolicy(d.Get("boolean_policy").([]interface{})),
+		ListPolicy:     listPolicy,
+		RestoreDefault: restoreDefault,
+	}
+
+	return policy, nil
+}
+
+func expandListOrganizationPolicy(configured []interface{}) (*caiasset.ListPolicy, error) {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil, nil
+	}
+
+	listPolicyMap := configured[0].(map[string]interface{})
+
+	allow := listPolicyMap["allow"].([]interface{})
+	deny := listPolicyMap["deny"].([]interface{})
+
+	allValues := caiasset.ListPolicyAllValuesUnspecified
+	var allowedValues []string
+	var deniedValues []string
+	if len(allow) > 0 && allow[0] != nil {
+		allowMap := allow[0].(map[string]interface{})
+		all := allowMap["all"].(bool)
+		values := allowMap["values"].(*schema.Set)
+
+		if all {
+			allValues = caiasset.ListPolicyAllValuesAllow
+		} else {
+			allowedValues = tpgresource.ConvertStringArr(values.List())
+		}
+	}
+
+	if len(deny) > 0 && deny[0] != nil {
+		denyMap := deny[0].(map[string]interface{})
+		all := denyMap["all"].(bool)
+		values := denyMap["values"].(*schema.Set)
+
+		if all {
+			allValues = caiasset.ListPolicyAllValuesDeny
+		} else {
+			deniedValues = tpgresource.ConvertStringArr(values.List())
+		}
+	}
+
+	return &caiasset.ListPolicy{
+		AllValues:         allValues,
+		AllowedValues:     allowedValues,
+		DeniedValues:      deniedValues,
+		SuggestedValue:    listPolicyMap["suggested_value"].(string),
+		InheritFromParent: listPolicyMap["inherit_from_parent"].(bool),
+	}, nil
+}
+
+func expandRestoreOrganizationPolicy(configured []interface{}) (*caiasset.RestoreDefault, error) {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil, nil
+	}
+
+	restoreDefaultMap := configured[0].(map[string]interface{})
+	defaultValue := restoreDefaultMap["default"].(bool)
+
+	if defaultValue {
+		return &caiasset.RestoreDefault{}, nil
+	}
+
+	return &caiasset.RestoreDefault{}, fmt.Errorf("Invalid value for restore_policy. Expecting default = true")
+}
+
+func expandBooleanOrganizationPolicy(configured []interface{}) *caiasset.BooleanPolicy {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	booleanPolicy := configured[0].(map[string]interface{})
+	return &caiasset.BooleanPolicy{
+		Enforced: booleanPolicy["enforced"].(bool),
+	}
+}
+
+func CanonicalOrgPolicyConstraint(constraint string) string {
+	if strings.HasPrefix(constraint, "constraints/") {
+		return constraint
+	}
+	return "constraints/" + constraint
+}
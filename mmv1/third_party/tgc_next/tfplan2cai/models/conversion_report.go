@@ -0,0 +1,100 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"sort"
+)
+
+// ConversionStatus is the outcome of converting a planned resource.
+type ConversionStatus string
+
+const (
+	// The resource was converted into CAI assets.
+	ConversionConverted ConversionStatus = "converted"
+	// There is no converter for the resource type.
+	ConversionUnsupported ConversionStatus = "unsupported"
+	// The converter cannot convert the resource in its planned state, for
+	// example because it has not been created yet.
+	ConversionSkipped ConversionStatus = "skipped"
+	// The conversion returned an error.
+	ConversionFailed ConversionStatus = "failed"
+)
+
+// ResourceConversion is the result of converting a single planned resource.
+type ResourceConversion struct {
+	// Address of the resource in the plan, e.g. google_project.my_project
+	Address string
+	// Terraform resource type, e.g. google_project
+	Kind   string
+	Status ConversionStatus
+	// Error the conversion failed with, if Status is ConversionFailed.
+	Err error
+}
+
+// ConversionReport records the result of converting each planned resource, so
+// that callers know which resources were not evaluated.
+type ConversionReport struct {
+	Resources []ResourceConversion
+}
+
+// Add records the result of converting a planned resource.
+func (r *ConversionReport) Add(rd *FakeResourceDataWithMeta, status ConversionStatus, err error) {
+	r.Resources = append(r.Resources, ResourceConversion{
+		Address: rd.Address(),
+		Kind:    rd.Kind(),
+		Status:  status,
+		Err:     err,
+	})
+}
+
+// Counts returns the number of resources with each status.
+func (r *ConversionReport) Counts() map[ConversionStatus]int {
+	counts := make(map[ConversionStatus]int)
+	for _, resource := range r.Resources {
+		counts[resource.Status]++
+	}
+	return counts
+}
+
+// WithStatus returns the resources with the given status.
+func (r *ConversionReport) WithStatus(status ConversionStatus) []ResourceConversion {
+	var resources []ResourceConversion
+	for _, resource := range r.Resources {
+		if resource.Status == status {
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
+
+// Unconverted returns the resources that were not converted into CAI assets.
+func (r *ConversionReport) Unconverted() []ResourceConversion {
+	var resources []ResourceConversion
+	for _, resource := range r.Resources {
+		if resource.Status != ConversionConverted {
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
+
+// Sort orders the resources by address, keeping the order of resources with
+// the same address.
+func (r *ConversionReport) Sort() {
+	sort.SliceStable(r.Resources, func(i, j int) bool {
+		return r.Resources[i].Address < r.Resources[j].Address
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversionReport(t *testing.T) {
+	report := &ConversionReport{}
+	failure := errors.New("failed")
+	report.Add(NewFakeResourceDataWithMeta("google_project", nil, nil, false, "google_project.b"), ConversionConverted, nil)
+	report.Add(NewFakeResourceDataWithMeta("google_foo", nil, nil, false, "google_foo.a"), ConversionUnsupported, nil)
+	report.Add(NewFakeResourceDataWithMeta("google_project", nil, nil, false, "google_project.a"), ConversionFailed, failure)
+	report.Add(NewFakeResourceDataWithMeta("google_project", nil, nil, true, "google_project.c"), ConversionConverted, nil)
+	report.Sort()
+
+	assert.Equal(t, []ResourceConversion{
+		{Address: "google_foo.a", Kind: "google_foo", Status: ConversionUnsupported},
+		{Address: "google_project.a", Kind: "google_project", Status: ConversionFailed, Err: failure},
+		{Address: "google_project.b", Kind: "google_project", Status: ConversionConverted},
+		{Address: "google_project.c", Kind: "google_project", Status: ConversionConverted},
+	}, report.Resources)
+	assert.Equal(t, map[ConversionStatus]int{
+		ConversionConverted:   2,
+		ConversionUnsupported: 1,
+		ConversionFailed:      1,
+	}, report.Counts())
+	assert.Equal(t, []ResourceConversion{
+		{Address: "google_project.a", Kind: "google_project", Status: ConversionFailed, Err: failure},
+	}, report.WithStatus(ConversionFailed))
+	assert.Equal(t, []ResourceConversion{
+		{Address: "google_foo.a", Kind: "google_foo", Status: ConversionUnsupported},
+		{Address: "google_project.a", Kind: "google_project", Status: ConversionFailed, Err: failure},
+	}, report.Unconverted())
+}
@@ -64,7 +64,7 @@ func (r *DefaultPreResolver) AddResourceChanges(changes []*tfjson.ResourceChange
 
 		var resourceData *models.FakeResourceDataWithMeta
 		resource := r.schema.ResourcesMap[rc.Type]
-		if tfplan.IsCreate(rc) || tfplan.IsUpdate(rc) || tfplan.IsDeleteCreate(rc) {
+		if tfplan.IsCreate(rc) || tfplan.IsUpdate(rc) || rc.Change.Actions.Replace() {
 			resourceData = models.NewFakeResourceDataWithMeta(
 				rc.Type,
 				resource.Schema,
@@ -93,3 +93,41 @@ func (r *DefaultPreResolver) AddResourceChanges(changes []*tfjson.ResourceChange
 
 	return resourceDataMap
 }
+
+// AddPriorResourceChanges processes the resource changes that update, replace
+// or delete a resource into resource data of the state of the resource before
+// the change.
+func (r *DefaultPreResolver) AddPriorResourceChanges(changes []*tfjson.ResourceChange) map[string][]*models.FakeResourceDataWithMeta {
+	resourceDataMap := make(map[string][]*models.FakeResourceDataWithMeta, 0)
+
+	for _, rc := range changes {
+		// Silently skip non-google resources
+		if !strings.HasPrefix(rc.Type, "google_") {
+			continue
+		}
+
+		resource, ok := r.schema.ResourcesMap[rc.Type]
+		if !ok {
+			continue
+		}
+
+		if !tfplan.IsUpdate(rc) && !tfplan.IsDelete(rc) && !rc.Change.Actions.Replace() {
+			continue
+		}
+		before, ok := rc.Change.Before.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		resourceData := models.NewFakeResourceDataWithMeta(
+			rc.Type,
+			resource.Schema,
+			before,
+			false,
+			rc.Address,
+		)
+		resourceDataMap[rc.Address] = append(resourceDataMap[rc.Address], resourceData)
+	}
+
+	return resourceDataMap
+}
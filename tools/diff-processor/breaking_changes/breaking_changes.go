@@ -12,15 +12,105 @@ type BreakingChange struct {
 	Message                string
 	DocumentationReference string
 	RuleName               string
+	Severity               Severity
+	// Exemption is the reviewed exemption that acknowledged this change, if any.
+	Exemption *Exemption `json:",omitempty"`
 }
 
+// Severity is whether a BreakingChange needs to block a change or is only a heads-up.
+type Severity string
+
+const (
+	// SeverityBlocking changes break existing configurations.
+	SeverityBlocking Severity = "blocking"
+	// SeverityInformational changes don't break existing configurations, but
+	// users need to act on them before a future release (e.g. deprecations).
+	SeverityInformational Severity = "informational"
+	// SeverityAcknowledged changes are blocking changes covered by a reviewed exemption.
+	SeverityAcknowledged Severity = "acknowledged"
+)
+
 const breakingChangesPath = "breaking-changes/breaking-changes"
 
-func NewBreakingChange(message, identifier string) BreakingChange {
+// functionPrefix is prepended to function names to get the Resource of their
+// BreakingChanges, matching how functions are called in configurations.
+const functionPrefix = "provider::google::"
+
+// NewBreakingChange returns a BreakingChange found by a rule on a resource (or field of
+// a resource). Rules are blocking unless they set a severity.
+func NewBreakingChange(resource, field, message, identifier string, severity Severity) BreakingChange {
+	if severity == "" {
+		severity = SeverityBlocking
+	}
 	return BreakingChange{
+		Resource:               resource,
+		Field:                  field,
 		Message:                message,
 		DocumentationReference: fmt.Sprintf("https://googlecloudplatform.github.io/magic-modules/%s#%s", breakingChangesPath, identifier),
+		RuleName:               identifier,
+		Severity:               severity,
+	}
+}
+
+// ComputeProviderBreakingChanges checks every kind of object in the provider schema
+// diff. Resources and ephemeral resources are checked against the resource rules, with
+// ephemeral resources reported using their Terraform address prefix (for example
+// `ephemeral.google_service_account_access_token`) to tell them apart from resources.
+// Data sources, the provider configuration and functions have their own rules.
+func ComputeProviderBreakingChanges(providerSchemaDiff diff.ProviderSchemaDiff) []BreakingChange {
+	breakingChanges := ComputeBreakingChanges(providerSchemaDiff.Resources)
+	breakingChanges = append(breakingChanges, ComputeBreakingChanges(prefixSchemaDiff("ephemeral.", providerSchemaDiff.EphemeralResources))...)
+	breakingChanges = append(breakingChanges, computeDataSourceBreakingChanges(providerSchemaDiff.DataSources)...)
+
+	for field, fieldDiff := range providerSchemaDiff.Provider.Fields {
+		for _, rule := range ProviderConfigDiffRules {
+			for _, message := range rule.Messages(field, fieldDiff) {
+				breakingChanges = append(breakingChanges, NewBreakingChange("", field, message, rule.Identifier, rule.Severity))
+			}
+		}
+	}
+
+	for function, functionDiff := range providerSchemaDiff.Functions {
+		for _, rule := range FunctionDiffRules {
+			for _, message := range rule.Messages(function, functionDiff) {
+				breakingChanges = append(breakingChanges, NewBreakingChange(functionPrefix+function, "", message, rule.Identifier, rule.Severity))
+			}
+		}
+	}
+	return breakingChanges
+}
+
+func computeDataSourceBreakingChanges(schemaDiff diff.SchemaDiff) []BreakingChange {
+	var breakingChanges []BreakingChange
+	for dataSource, dataSourceDiff := range schemaDiff {
+		for _, rule := range DataSourceConfigDiffRules {
+			for _, message := range rule.Messages(dataSource, dataSourceDiff.ResourceConfig) {
+				breakingChanges = append(breakingChanges, NewBreakingChange(dataSource, "", message, rule.Identifier, rule.Severity))
+			}
+		}
+
+		// If the data source was added or removed, don't check rules that include field information.
+		if dataSourceDiff.ResourceConfig.Old == nil || dataSourceDiff.ResourceConfig.New == nil {
+			continue
+		}
+
+		for field, fieldDiff := range dataSourceDiff.Fields {
+			for _, rule := range DataSourceFieldDiffRules {
+				for _, message := range rule.Messages(dataSource, field, fieldDiff) {
+					breakingChanges = append(breakingChanges, NewBreakingChange(dataSource, field, message, rule.Identifier, rule.Severity))
+				}
+			}
+		}
+	}
+	return breakingChanges
+}
+
+func prefixSchemaDiff(prefix string, schemaDiff diff.SchemaDiff) diff.SchemaDiff {
+	prefixed := make(diff.SchemaDiff, len(schemaDiff))
+	for name, resourceDiff := range schemaDiff {
+		prefixed[prefix+name] = resourceDiff
 	}
+	return prefixed
 }
 
 func ComputeBreakingChanges(schemaDiff diff.SchemaDiff) []BreakingChange {
@@ -28,7 +118,7 @@ func ComputeBreakingChanges(schemaDiff diff.SchemaDiff) []BreakingChange {
 	for resource, resourceDiff := range schemaDiff {
 		for _, rule := range ResourceConfigDiffRules {
 			for _, message := range rule.Messages(resource, resourceDiff.ResourceConfig) {
-				breakingChanges = append(breakingChanges, NewBreakingChange(message, rule.Identifier))
+				breakingChanges = append(breakingChanges, NewBreakingChange(resource, "", message, rule.Identifier, rule.Severity))
 			}
 		}
 
@@ -39,14 +129,14 @@ func ComputeBreakingChanges(schemaDiff diff.SchemaDiff) []BreakingChange {
 
 		for _, rule := range ResourceDiffRules {
 			for _, message := range rule.Messages(resource, resourceDiff) {
-				breakingChanges = append(breakingChanges, NewBreakingChange(message, rule.Identifier))
+				breakingChanges = append(breakingChanges, NewBreakingChange(resource, "", message, rule.Identifier, rule.Severity))
 			}
 		}
 
 		for field, fieldDiff := range resourceDiff.Fields {
 			for _, rule := range FieldDiffRules {
 				for _, message := range rule.Messages(resource, field, fieldDiff) {
-					breakingChanges = append(breakingChanges, NewBreakingChange(message, rule.Identifier))
+					breakingChanges = append(breakingChanges, NewBreakingChange(resource, field, message, rule.Identifier, rule.Severity))
 				}
 			}
 		}
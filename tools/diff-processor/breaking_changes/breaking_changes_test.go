@@ -7,6 +7,8 @@ import (
 
 	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -93,8 +95,11 @@ func TestComputeBreakingChanges(t *testing.T) {
 			newResourceMap: map[string]*schema.Resource{},
 			wantViolations: []BreakingChange{
 				{
+					Resource:               "google-x",
 					Message:                "Resource `google-x` was either removed or renamed",
 					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#resource-map-resource-removal-or-rename",
+					RuleName:               "resource-map-resource-removal-or-rename",
+					Severity:               SeverityBlocking,
 				},
 			},
 		},
@@ -117,8 +122,40 @@ func TestComputeBreakingChanges(t *testing.T) {
 			},
 			wantViolations: []BreakingChange{
 				{
+					Resource:               "google-x",
+					Field:                  "field-b",
+					Message:                "Field `field-b` within resource `google-x` was either removed or renamed without being deprecated first",
+					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#resource-schema-field-removal-or-rename",
+					RuleName:               "resource-schema-field-removal-or-rename",
+					Severity:               SeverityBlocking,
+				},
+			},
+		},
+		{
+			name: "deprecated field missing",
+			oldResourceMap: map[string]*schema.Resource{
+				"google-x": {
+					Schema: map[string]*schema.Schema{
+						"field-a": {Description: "beep", Optional: true},
+						"field-b": {Description: "beep", Optional: true, Deprecated: "use `field-a` instead"},
+					},
+				},
+			},
+			newResourceMap: map[string]*schema.Resource{
+				"google-x": {
+					Schema: map[string]*schema.Schema{
+						"field-a": {Description: "beep", Optional: true},
+					},
+				},
+			},
+			wantViolations: []BreakingChange{
+				{
+					Resource:               "google-x",
+					Field:                  "field-b",
 					Message:                "Field `field-b` within resource `google-x` was either removed or renamed",
 					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#resource-schema-field-removal-or-rename",
+					RuleName:               "resource-schema-field-removal-or-rename",
+					Severity:               SeverityBlocking,
 				},
 			},
 		},
@@ -142,8 +179,12 @@ func TestComputeBreakingChanges(t *testing.T) {
 			},
 			wantViolations: []BreakingChange{
 				{
+					Resource:               "google-x",
+					Field:                  "field-a",
 					Message:                "Field `field-a` changed from optional to required on `google-x`",
 					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#field-optional-to-required",
+					RuleName:               "field-optional-to-required",
+					Severity:               SeverityBlocking,
 				},
 			},
 		},
@@ -166,12 +207,20 @@ func TestComputeBreakingChanges(t *testing.T) {
 			},
 			wantViolations: []BreakingChange{
 				{
+					Resource:               "google-x",
+					Field:                  "field-a",
 					Message:                "Field `field-a` changed from optional to required on `google-x`",
 					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#field-optional-to-required",
+					RuleName:               "field-optional-to-required",
+					Severity:               SeverityBlocking,
 				},
 				{
-					Message:                "Field `field-b` within resource `google-x` was either removed or renamed",
+					Resource:               "google-x",
+					Field:                  "field-b",
+					Message:                "Field `field-b` within resource `google-x` was either removed or renamed without being deprecated first",
 					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#resource-schema-field-removal-or-rename",
+					RuleName:               "resource-schema-field-removal-or-rename",
+					Severity:               SeverityBlocking,
 				},
 			},
 		},
@@ -199,16 +248,27 @@ func TestComputeBreakingChanges(t *testing.T) {
 			},
 			wantViolations: []BreakingChange{
 				{
+					Resource:               "google-x",
+					Field:                  "field-a",
 					Message:                "Field `field-a` changed from optional to required on `google-x`",
 					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#field-optional-to-required",
+					RuleName:               "field-optional-to-required",
+					Severity:               SeverityBlocking,
 				},
 				{
-					Message:                "Field `field-b` within resource `google-x` was either removed or renamed",
+					Resource:               "google-x",
+					Field:                  "field-b",
+					Message:                "Field `field-b` within resource `google-x` was either removed or renamed without being deprecated first",
 					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#resource-schema-field-removal-or-rename",
+					RuleName:               "resource-schema-field-removal-or-rename",
+					Severity:               SeverityBlocking,
 				},
 				{
+					Resource:               "google-y",
 					Message:                "Resource `google-y` was either removed or renamed",
 					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#resource-map-resource-removal-or-rename",
+					RuleName:               "resource-map-resource-removal-or-rename",
+					Severity:               SeverityBlocking,
 				},
 			},
 		},
@@ -253,8 +313,12 @@ func TestComputeBreakingChanges(t *testing.T) {
 			},
 			wantViolations: []BreakingChange{
 				{
-					Message:                "Field `field-a.sub-field-2` within resource `google-x` was either removed or renamed",
+					Resource:               "google-x",
+					Field:                  "field-a.sub-field-2",
+					Message:                "Field `field-a.sub-field-2` within resource `google-x` was either removed or renamed without being deprecated first",
 					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#resource-schema-field-removal-or-rename",
+					RuleName:               "resource-schema-field-removal-or-rename",
+					Severity:               SeverityBlocking,
 				},
 			},
 		},
@@ -298,8 +362,12 @@ func TestComputeBreakingChanges(t *testing.T) {
 			},
 			wantViolations: []BreakingChange{
 				{
+					Resource:               "google-x",
+					Field:                  "field-a.sub-field-1",
 					Message:                "Field `field-a.sub-field-1` MaxItems went from 100 to 25 on `google-x`",
 					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#field-shrinking-max",
+					RuleName:               "field-shrinking-max",
+					Severity:               SeverityBlocking,
 				},
 			},
 		},
@@ -343,8 +411,12 @@ func TestComputeBreakingChanges(t *testing.T) {
 			},
 			wantViolations: []BreakingChange{
 				{
+					Resource:               "google-x",
+					Field:                  "field-a.sub-field-1",
 					Message:                "Field `field-a.sub-field-1` MaxItems went from 100 to 25 on `google-x`",
 					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#field-shrinking-max",
+					RuleName:               "field-shrinking-max",
+					Severity:               SeverityBlocking,
 				},
 			},
 		},
@@ -374,8 +446,47 @@ func TestComputeBreakingChanges(t *testing.T) {
 			},
 			wantViolations: []BreakingChange{
 				{
+					Resource:               "google-x",
+					Field:                  "field-a",
 					Message:                "Field `field-a` MinItems went from 1 to 4 on `google-x`",
 					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#field-growing-min",
+					RuleName:               "field-growing-min",
+					Severity:               SeverityBlocking,
+				},
+			},
+		},
+		{
+			name: "deprecating a resource and a field",
+			oldResourceMap: map[string]*schema.Resource{
+				"google-x": {
+					Schema: map[string]*schema.Schema{
+						"field-a": {Description: "beep", Optional: true},
+					},
+				},
+			},
+			newResourceMap: map[string]*schema.Resource{
+				"google-x": {
+					DeprecationMessage: "boop",
+					Schema: map[string]*schema.Schema{
+						"field-a": {Description: "beep", Optional: true, Deprecated: "boop"},
+					},
+				},
+			},
+			wantViolations: []BreakingChange{
+				{
+					Resource:               "google-x",
+					Field:                  "field-a",
+					Message:                "Field `field-a` within resource `google-x` was deprecated",
+					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#field-deprecation",
+					RuleName:               "field-deprecation",
+					Severity:               SeverityInformational,
+				},
+				{
+					Resource:               "google-x",
+					Message:                "Resource `google-x` was deprecated",
+					DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#resource-deprecation",
+					RuleName:               "resource-deprecation",
+					Severity:               SeverityInformational,
 				},
 			},
 		},
@@ -401,3 +512,69 @@ func TestComputeBreakingChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeProviderBreakingChanges(t *testing.T) {
+	oldSchema := diff.ProviderSchema{
+		Provider: &schema.Resource{Schema: map[string]*schema.Schema{
+			"field-a": {Description: "beep", Optional: true},
+		}},
+		DataSources: map[string]*schema.Resource{
+			"google-x": {Schema: map[string]*schema.Schema{
+				"field-a": {Description: "beep", Optional: true},
+			}},
+		},
+		EphemeralResources: map[string]*schema.Resource{
+			"google-x": {Schema: map[string]*schema.Schema{}},
+		},
+		Functions: map[string]*tfprotov5.Function{
+			"f": {Return: &tfprotov5.FunctionReturn{Type: tftypes.String}},
+		},
+	}
+	newSchema := diff.ProviderSchema{
+		Provider: &schema.Resource{Schema: map[string]*schema.Schema{
+			"field-a": {Description: "beep", Required: true},
+		}},
+		DataSources: map[string]*schema.Resource{
+			"google-x": {Schema: map[string]*schema.Schema{}},
+		},
+	}
+	wantViolations := []BreakingChange{
+		{
+			Resource:               "google-x",
+			Field:                  "field-a",
+			Message:                "Field `field-a` within data source `google-x` was either removed or renamed",
+			DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#data-source-field-removal-or-rename",
+			RuleName:               "data-source-field-removal-or-rename",
+			Severity:               SeverityBlocking,
+		},
+		{
+			Resource:               "provider::google::f",
+			Message:                "Function `f` was either removed or renamed",
+			DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#function-removal-or-rename",
+			RuleName:               "function-removal-or-rename",
+			Severity:               SeverityBlocking,
+		},
+		{
+			Field:                  "field-a",
+			Message:                "Provider field `field-a` is now required",
+			DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#provider-config-field-optional-to-required",
+			RuleName:               "provider-config-field-optional-to-required",
+			Severity:               SeverityBlocking,
+		},
+		{
+			Resource:               "ephemeral.google-x",
+			Message:                "Resource `ephemeral.google-x` was either removed or renamed",
+			DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#resource-map-resource-removal-or-rename",
+			RuleName:               "resource-map-resource-removal-or-rename",
+			Severity:               SeverityBlocking,
+		},
+	}
+
+	violations := ComputeProviderBreakingChanges(diff.ComputeProviderSchemaDiff(oldSchema, newSchema))
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Message < violations[j].Message
+	})
+	if diff := cmp.Diff(wantViolations, violations); diff != "" {
+		t.Errorf("violation diff(-want, +got) = %s", diff)
+	}
+}
@@ -0,0 +1,75 @@
+package breaking_changes
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+)
+
+// DataSourceConfigDiffRules is a list of ResourceConfigDiffRule
+// guarding against data source breaking changes
+var DataSourceConfigDiffRules = []ResourceConfigDiffRule{DataSourceConfigRemovingADataSource}
+
+// DataSourceFieldDiffRules is a list of FieldDiffRule
+// guarding against data source field breaking changes
+var DataSourceFieldDiffRules = []FieldDiffRule{
+	DataSourceFieldRemoval,
+	DataSourceFieldBecomingRequired,
+	DataSourceFieldChangingType,
+}
+
+var DataSourceConfigRemovingADataSource = ResourceConfigDiffRule{
+	Identifier: "data-source-map-data-source-removal-or-rename",
+	Messages:   DataSourceConfigRemovingADataSourceMessages,
+}
+
+func DataSourceConfigRemovingADataSourceMessages(dataSource string, resourceConfigDiff diff.ResourceConfigDiff) []string {
+	if resourceConfigDiff.New == nil && resourceConfigDiff.Old != nil {
+		tmpl := "Data source `%s` was either removed or renamed"
+		return []string{fmt.Sprintf(tmpl, dataSource)}
+	}
+	return nil
+}
+
+var DataSourceFieldRemoval = FieldDiffRule{
+	Identifier: "data-source-field-removal-or-rename",
+	Messages:   DataSourceFieldRemovalMessages,
+}
+
+func DataSourceFieldRemovalMessages(dataSource, field string, fieldDiff diff.FieldDiff) []string {
+	if fieldDiff.Old != nil && fieldDiff.New == nil {
+		tmpl := "Field `%s` within data source `%s` was either removed or renamed"
+		return []string{fmt.Sprintf(tmpl, field, dataSource)}
+	}
+	return nil
+}
+
+var DataSourceFieldBecomingRequired = FieldDiffRule{
+	Identifier: "data-source-field-optional-to-required",
+	Messages:   DataSourceFieldBecomingRequiredMessages,
+}
+
+func DataSourceFieldBecomingRequiredMessages(dataSource, field string, fieldDiff diff.FieldDiff) []string {
+	// A new required field breaks every existing data source block
+	if fieldDiff.New == nil || !fieldDiff.New.Required {
+		return nil
+	}
+	if fieldDiff.Old == nil || !fieldDiff.Old.Required {
+		tmpl := "Field `%s` is now required on data source `%s`"
+		return []string{fmt.Sprintf(tmpl, field, dataSource)}
+	}
+	return nil
+}
+
+var DataSourceFieldChangingType = FieldDiffRule{
+	Identifier: "data-source-field-changing-type",
+	Messages:   DataSourceFieldChangingTypeMessages,
+}
+
+func DataSourceFieldChangingTypeMessages(dataSource, field string, fieldDiff diff.FieldDiff) []string {
+	if oldType, newType, changed := fieldTypeChange(fieldDiff); changed {
+		tmpl := "Field `%s` changed from %s to %s on data source `%s`"
+		return []string{fmt.Sprintf(tmpl, field, oldType, newType, dataSource)}
+	}
+	return nil
+}
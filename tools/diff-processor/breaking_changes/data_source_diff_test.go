@@ -0,0 +1,112 @@
+package breaking_changes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+)
+
+func TestDataSourceConfigRemovingADataSource(t *testing.T) {
+	for _, tc := range resourceConfigRemovingAResourceTestCases {
+		got := DataSourceConfigRemovingADataSource.Messages("data_source", diff.ResourceConfigDiff{Old: tc.old, New: tc.new})
+		gotViolations := len(got) > 0
+		if tc.wantViolations != gotViolations {
+			t.Errorf("DataSourceConfigRemovingADataSource.Messages(%v) violations not expected. Got %v, want %v", tc.name, gotViolations, tc.wantViolations)
+		}
+	}
+}
+
+func TestDataSourceFieldRemoval(t *testing.T) {
+	for _, tc := range dataSourceFieldRemovalTestCases {
+		tc.check(DataSourceFieldRemoval, t)
+	}
+}
+
+var dataSourceFieldRemovalTestCases = []fieldTestCase{
+	{
+		name:              "control",
+		oldField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+		newField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+		expectedViolation: false,
+	},
+	{
+		name:              "field added",
+		newField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+		expectedViolation: false,
+	},
+	{
+		name:              "field removed",
+		oldField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+		expectedViolation: true,
+		messageRegex:      "Field `field` within data source `resource` was either removed or renamed",
+	},
+}
+
+func TestDataSourceFieldBecomingRequired(t *testing.T) {
+	for _, tc := range dataSourceFieldBecomingRequiredTestCases {
+		tc.check(DataSourceFieldBecomingRequired, t)
+	}
+}
+
+var dataSourceFieldBecomingRequiredTestCases = []fieldTestCase{
+	{
+		name:              "control",
+		oldField:          &schema.Schema{Type: schema.TypeString, Required: true},
+		newField:          &schema.Schema{Type: schema.TypeString, Required: true},
+		expectedViolation: false,
+	},
+	{
+		name:              "optional to required",
+		oldField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+		newField:          &schema.Schema{Type: schema.TypeString, Required: true},
+		expectedViolation: true,
+		messageRegex:      "Field `field` is now required on data source `resource`",
+	},
+	{
+		name:              "required field added",
+		newField:          &schema.Schema{Type: schema.TypeString, Required: true},
+		expectedViolation: true,
+	},
+	{
+		name:              "optional field added",
+		newField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+		expectedViolation: false,
+	},
+	{
+		name:              "required to optional",
+		oldField:          &schema.Schema{Type: schema.TypeString, Required: true},
+		newField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+		expectedViolation: false,
+	},
+}
+
+func TestDataSourceFieldChangingType(t *testing.T) {
+	for _, tc := range dataSourceFieldChangingTypeTestCases {
+		tc.check(DataSourceFieldChangingType, t)
+	}
+}
+
+var dataSourceFieldChangingTypeTestCases = []fieldTestCase{
+	{
+		name:              "control",
+		oldField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+		newField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+		expectedViolation: false,
+	},
+	{
+		name:              "type changed",
+		oldField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+		newField:          &schema.Schema{Type: schema.TypeInt, Optional: true},
+		expectedViolation: true,
+		messageRegex:      "Field `field` changed from TypeString to TypeInt on data source `resource`",
+	},
+	{
+		name:              "element type changed",
+		oldField:          &schema.Schema{Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeString}},
+		newField:          &schema.Schema{Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeInt}},
+		expectedViolation: true,
+		messageRegex:      "TypeList.TypeString to TypeList.TypeInt",
+	},
+}
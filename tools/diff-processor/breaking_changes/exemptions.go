@@ -0,0 +1,130 @@
+package breaking_changes
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// exemptionDateFormat is the format of the expiry date of exemptions.
+const exemptionDateFormat = "2006-01-02"
+
+// Exemption records a reviewed, intentional breaking change (such as a removal in a
+// major release or a change forced by the API). Breaking changes matching the
+// resource, field and rule of an exemption are acknowledged instead of blocking.
+// Resource-level and provider-level rules match exemptions without a field.
+type Exemption struct {
+	Resource string `yaml:"resource,omitempty"`
+	Field    string `yaml:"field,omitempty"`
+	Rule     string `yaml:"rule"`
+	Reason   string `yaml:"reason"`
+	Approver string `yaml:"approver"`
+	// Expiry is the last day (YYYY-MM-DD) the exemption can be used.
+	Expiry string `yaml:"expiry,omitempty"`
+	// MajorVersion is the major release the change ships in; the exemption is stale
+	// once changes target a later major version.
+	MajorVersion int `yaml:"major_version,omitempty"`
+}
+
+// ExemptionsFile is the format of the file holding exemptions.
+type ExemptionsFile struct {
+	Exemptions []Exemption `yaml:"exemptions"`
+}
+
+// ReadExemptions reads and validates the exemptions in the file at path.
+func ReadExemptions(path string) ([]Exemption, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file ExemptionsFile
+	if err := yaml.Unmarshal(b, &file); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	var errs []error
+	for _, exemption := range file.Exemptions {
+		if err := exemption.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid exemptions in %s:\n%w", path, err)
+	}
+	return file.Exemptions, nil
+}
+
+func (e Exemption) String() string {
+	return fmt.Sprintf("exemption for rule %q on resource %q field %q", e.Rule, e.Resource, e.Field)
+}
+
+func (e Exemption) validate() error {
+	var missing []string
+	if e.Rule == "" {
+		missing = append(missing, "rule")
+	}
+	if e.Reason == "" {
+		missing = append(missing, "reason")
+	}
+	if e.Approver == "" {
+		missing = append(missing, "approver")
+	}
+	if e.Expiry == "" && e.MajorVersion == 0 {
+		missing = append(missing, "expiry or major_version")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s is missing %v", e, missing)
+	}
+	if e.Expiry != "" {
+		if _, err := time.Parse(exemptionDateFormat, e.Expiry); err != nil {
+			return fmt.Errorf("%s has an invalid expiry: %w", e, err)
+		}
+	}
+	return nil
+}
+
+// stale returns whether the exemption can no longer be used at now, or for changes
+// targeting majorVersion (0 if unknown).
+func (e Exemption) stale(now time.Time, majorVersion int) bool {
+	if e.Expiry != "" {
+		// Exemptions are valid until the end of their expiry day.
+		expiry, _ := time.Parse(exemptionDateFormat, e.Expiry)
+		if !now.Before(expiry.AddDate(0, 0, 1)) {
+			return true
+		}
+	}
+	return e.MajorVersion != 0 && majorVersion > e.MajorVersion
+}
+
+func (e Exemption) matches(breakingChange BreakingChange) bool {
+	return e.Resource == breakingChange.Resource && e.Field == breakingChange.Field && e.Rule == breakingChange.RuleName
+}
+
+// ApplyExemptions acknowledges the blocking changes matched by an exemption. It also
+// returns the exemptions that are stale, which must be removed, and the exemptions that
+// match none of the changes, such as exemptions of changes made in other pull requests
+// or already released. The returned breaking changes are valid either way.
+func ApplyExemptions(breakingChanges []BreakingChange, exemptions []Exemption, now time.Time, majorVersion int) (acknowledged []BreakingChange, stale, unmatched []Exemption) {
+	for i := range exemptions {
+		exemption := &exemptions[i]
+		if exemption.stale(now, majorVersion) {
+			stale = append(stale, *exemption)
+			continue
+		}
+		matched := false
+		for j := range breakingChanges {
+			if breakingChanges[j].Severity != SeverityBlocking || !exemption.matches(breakingChanges[j]) {
+				continue
+			}
+			breakingChanges[j].Severity = SeverityAcknowledged
+			breakingChanges[j].Exemption = exemption
+			matched = true
+		}
+		if !matched {
+			unmatched = append(unmatched, *exemption)
+		}
+	}
+	return breakingChanges, stale, unmatched
+}
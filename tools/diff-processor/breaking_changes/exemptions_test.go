@@ -0,0 +1,156 @@
+package breaking_changes
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadExemptions(t *testing.T) {
+	got, err := ReadExemptions("../testdata/exemptions.yaml")
+	if err != nil {
+		t.Fatalf("ReadExemptions() returned error: %v", err)
+	}
+	want := []Exemption{
+		{
+			Resource:     "google_pubsub_topic",
+			Field:        "message_retention_duration",
+			Rule:         "resource-schema-field-removal-or-rename",
+			Reason:       "Removed in favor of `message_retention_period` in the 7.0.0 major release",
+			Approver:     "reviewer",
+			MajorVersion: 7,
+		},
+		{
+			Resource: "google_pubsub_lite_reservation",
+			Rule:     "resource-map-resource-removal-or-rename",
+			Reason:   "The Pub/Sub Lite API was shut down",
+			Approver: "reviewer",
+			Expiry:   "2026-03-18",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ReadExemptions() unexpected diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestReadExemptionsInvalid(t *testing.T) {
+	cases := map[string]string{
+		"missing approver": `
+exemptions:
+  - resource: 'google_x'
+    rule: 'resource-map-resource-removal-or-rename'
+    reason: 'beep'
+    expiry: '2026-03-18'
+`,
+		"missing expiry": `
+exemptions:
+  - resource: 'google_x'
+    rule: 'resource-map-resource-removal-or-rename'
+    reason: 'beep'
+    approver: 'reviewer'
+`,
+		"invalid expiry": `
+exemptions:
+  - resource: 'google_x'
+    rule: 'resource-map-resource-removal-or-rename'
+    reason: 'beep'
+    approver: 'reviewer'
+    expiry: 'March 18th'
+`,
+	}
+	for tn, contents := range cases {
+		path := filepath.Join(t.TempDir(), "exemptions.yaml")
+		if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ReadExemptions(path); err == nil {
+			t.Errorf("ReadExemptions(%s) returned no error", tn)
+		}
+	}
+}
+
+func TestApplyExemptions(t *testing.T) {
+	now := time.Date(2026, 3, 18, 12, 0, 0, 0, time.UTC)
+	fieldRemoval := NewBreakingChange("google_x", "field_a", "Field `field_a` within resource `google_x` was either removed or renamed", "resource-schema-field-removal-or-rename", "")
+	resourceRemoval := NewBreakingChange("google_y", "", "Resource `google_y` was either removed or renamed", "resource-map-resource-removal-or-rename", "")
+	deprecation := NewBreakingChange("google_z", "", "Resource `google_z` was deprecated", "resource-deprecation", SeverityInformational)
+
+	fieldRemovalExemption := Exemption{Resource: "google_x", Field: "field_a", Rule: "resource-schema-field-removal-or-rename", Reason: "beep", Approver: "reviewer", MajorVersion: 7}
+	resourceRemovalExemption := Exemption{Resource: "google_y", Rule: "resource-map-resource-removal-or-rename", Reason: "beep", Approver: "reviewer", Expiry: "2026-03-18"}
+
+	cases := []struct {
+		name          string
+		exemptions    []Exemption
+		majorVersion  int
+		wantSeverity  []Severity
+		wantStale     int
+		wantUnmatched int
+	}{
+		{
+			name:         "no exemptions",
+			wantSeverity: []Severity{SeverityBlocking, SeverityBlocking, SeverityInformational},
+		},
+		{
+			name:         "matching exemptions",
+			exemptions:   []Exemption{fieldRemovalExemption, resourceRemovalExemption},
+			majorVersion: 7,
+			wantSeverity: []Severity{SeverityAcknowledged, SeverityAcknowledged, SeverityInformational},
+		},
+		{
+			name:         "exemption for a later major version",
+			exemptions:   []Exemption{fieldRemovalExemption},
+			majorVersion: 8,
+			wantSeverity: []Severity{SeverityBlocking, SeverityBlocking, SeverityInformational},
+			wantStale:    1,
+		},
+		{
+			name: "expired exemption",
+			exemptions: []Exemption{
+				{Resource: "google_y", Rule: "resource-map-resource-removal-or-rename", Reason: "beep", Approver: "reviewer", Expiry: "2026-03-17"},
+			},
+			wantSeverity: []Severity{SeverityBlocking, SeverityBlocking, SeverityInformational},
+			wantStale:    1,
+		},
+		{
+			name: "exemption for another field",
+			exemptions: []Exemption{
+				{Resource: "google_x", Field: "field_b", Rule: "resource-schema-field-removal-or-rename", Reason: "beep", Approver: "reviewer", MajorVersion: 7},
+			},
+			wantSeverity:  []Severity{SeverityBlocking, SeverityBlocking, SeverityInformational},
+			wantUnmatched: 1,
+		},
+		{
+			name: "exemption for an informational change",
+			exemptions: []Exemption{
+				{Resource: "google_z", Rule: "resource-deprecation", Reason: "beep", Approver: "reviewer", MajorVersion: 7},
+			},
+			wantSeverity:  []Severity{SeverityBlocking, SeverityBlocking, SeverityInformational},
+			wantUnmatched: 1,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			breakingChanges := []BreakingChange{fieldRemoval, resourceRemoval, deprecation}
+			got, stale, unmatched := ApplyExemptions(breakingChanges, tc.exemptions, now, tc.majorVersion)
+			if len(stale) != tc.wantStale {
+				t.Errorf("ApplyExemptions() returned stale exemptions %v, want %d", stale, tc.wantStale)
+			}
+			if len(unmatched) != tc.wantUnmatched {
+				t.Errorf("ApplyExemptions() returned unmatched exemptions %v, want %d", unmatched, tc.wantUnmatched)
+			}
+			var gotSeverity []Severity
+			for _, breakingChange := range got {
+				gotSeverity = append(gotSeverity, breakingChange.Severity)
+				if (breakingChange.Severity == SeverityAcknowledged) != (breakingChange.Exemption != nil) {
+					t.Errorf("ApplyExemptions() unexpected exemption %v for %q", breakingChange.Exemption, breakingChange.Message)
+				}
+			}
+			if diff := cmp.Diff(tc.wantSeverity, gotSeverity); diff != "" {
+				t.Errorf("ApplyExemptions() unexpected severities (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -14,11 +14,13 @@ import (
 type FieldDiffRule struct {
 	Identifier string
 	Messages   func(resource, field string, fieldDiff diff.FieldDiff) []string
+	Severity   Severity
 }
 
 // FieldDiffRules is a list of FieldDiffRule
 // guarding against provider breaking changes
 var FieldDiffRules = []FieldDiffRule{
+	RemovingAField,
 	FieldChangingType,
 	FieldBecomingRequired,
 	FieldBecomingComputedOnly,
@@ -27,6 +29,29 @@ var FieldDiffRules = []FieldDiffRule{
 	FieldGrowingMin,
 	FieldShrinkingMax,
 	FieldRemovingDiffSuppress,
+	FieldBecomingForceNew,
+	FieldRemovingSensitive,
+	FieldNewlyDeprecated,
+	FieldRemovingEnumValue,
+	FieldNarrowingValidation,
+	FieldChangingValidationPattern,
+}
+
+var RemovingAField = FieldDiffRule{
+	Identifier: "resource-schema-field-removal-or-rename",
+	Messages:   RemovingAFieldMessages,
+}
+
+func RemovingAFieldMessages(resource, field string, fieldDiff diff.FieldDiff) []string {
+	tmpl := "Field `%s` within resource `%s` was either removed or renamed"
+	if fieldDiff.Old == nil || fieldDiff.New != nil {
+		return nil
+	}
+	// Fields must be deprecated in a release before the one removing them.
+	if fieldDiff.Old.Deprecated == "" {
+		tmpl += " without being deprecated first"
+	}
+	return []string{fmt.Sprintf(tmpl, field, resource)}
 }
 
 var FieldChangingType = FieldDiffRule{
@@ -35,15 +60,22 @@ var FieldChangingType = FieldDiffRule{
 }
 
 func FieldChangingTypeMessages(resource, field string, fieldDiff diff.FieldDiff) []string {
+	tmpl := "Field `%s` changed from %s to %s on `%s`"
+	if oldType, newType, changed := fieldTypeChange(fieldDiff); changed {
+		return []string{fmt.Sprintf(tmpl, field, oldType, newType, resource)}
+	}
+	return nil
+}
+
+// fieldTypeChange returns the old and new type of a field (including the type of its
+// elements) if it changed.
+func fieldTypeChange(fieldDiff diff.FieldDiff) (string, string, bool) {
 	// Type change doesn't matter for added / removed fields
 	if fieldDiff.Old == nil || fieldDiff.New == nil {
-		return nil
+		return "", "", false
 	}
-	tmpl := "Field `%s` changed from %s to %s on `%s`"
 	if fieldDiff.Old.Type != fieldDiff.New.Type {
-		oldType := getValueType(fieldDiff.Old.Type)
-		newType := getValueType(fieldDiff.New.Type)
-		return []string{fmt.Sprintf(tmpl, field, oldType, newType, resource)}
+		return getValueType(fieldDiff.Old.Type), getValueType(fieldDiff.New.Type), true
 	}
 
 	oldCasted, _ := fieldDiff.Old.Elem.(*schema.Schema)
@@ -51,10 +83,10 @@ func FieldChangingTypeMessages(resource, field string, fieldDiff diff.FieldDiff)
 	if oldCasted != nil && newCasted != nil && oldCasted.Type != newCasted.Type {
 		oldType := getValueType(fieldDiff.Old.Type) + "." + getValueType(oldCasted.Type)
 		newType := getValueType(fieldDiff.New.Type) + "." + getValueType(newCasted.Type)
-		return []string{fmt.Sprintf(tmpl, field, oldType, newType, resource)}
+		return oldType, newType, true
 	}
 
-	return nil
+	return "", "", false
 }
 
 var FieldBecomingRequired = FieldDiffRule{
@@ -214,3 +246,146 @@ func FieldRemovingDiffSuppressMessages(resource, field string, fieldDiff diff.Fi
 	}
 	return nil
 }
+
+var FieldBecomingForceNew = FieldDiffRule{
+	Identifier: "field-becoming-force-new",
+	Messages:   FieldBecomingForceNewMessages,
+}
+
+func FieldBecomingForceNewMessages(resource, field string, fieldDiff diff.FieldDiff) []string {
+	// ignore for added / removed fields
+	if fieldDiff.Old == nil || fieldDiff.New == nil {
+		return nil
+	}
+	tmpl := "Field `%s` changed to ForceNew on `%s`, so updating it will recreate the resource"
+	if !fieldDiff.Old.ForceNew && fieldDiff.New.ForceNew {
+		return []string{fmt.Sprintf(tmpl, field, resource)}
+	}
+	return nil
+}
+
+var FieldRemovingSensitive = FieldDiffRule{
+	Identifier: "field-removing-sensitive",
+	Messages:   FieldRemovingSensitiveMessages,
+}
+
+func FieldRemovingSensitiveMessages(resource, field string, fieldDiff diff.FieldDiff) []string {
+	// ignore for added / removed fields
+	if fieldDiff.Old == nil || fieldDiff.New == nil {
+		return nil
+	}
+	tmpl := "Field `%s` is no longer sensitive on `%s`"
+	if fieldDiff.Old.Sensitive && !fieldDiff.New.Sensitive {
+		return []string{fmt.Sprintf(tmpl, field, resource)}
+	}
+	return nil
+}
+
+var FieldNewlyDeprecated = FieldDiffRule{
+	Identifier: "field-deprecation",
+	Messages:   FieldNewlyDeprecatedMessages,
+	Severity:   SeverityInformational,
+}
+
+func FieldNewlyDeprecatedMessages(resource, field string, fieldDiff diff.FieldDiff) []string {
+	// ignore for added / removed fields
+	if fieldDiff.Old == nil || fieldDiff.New == nil {
+		return nil
+	}
+	tmpl := "Field `%s` within resource `%s` was deprecated"
+	if fieldDiff.Old.Deprecated == "" && fieldDiff.New.Deprecated != "" {
+		return []string{fmt.Sprintf(tmpl, field, resource)}
+	}
+	return nil
+}
+
+var FieldRemovingEnumValue = FieldDiffRule{
+	Identifier: "field-removing-enum-value",
+	Messages:   FieldRemovingEnumValueMessages,
+}
+
+func FieldRemovingEnumValueMessages(resource, field string, fieldDiff diff.FieldDiff) []string {
+	// ignore for added / removed fields and fields without metadata
+	if fieldDiff.OldMetadata == nil || fieldDiff.NewMetadata == nil {
+		return nil
+	}
+	// a field that is no longer an enum accepts any value
+	if len(fieldDiff.NewMetadata.EnumValues) == 0 {
+		return nil
+	}
+	newValues := make(map[string]bool)
+	for _, value := range fieldDiff.NewMetadata.EnumValues {
+		newValues[value] = true
+	}
+	tmpl := "Field `%s` no longer accepts value `%s` on `%s`"
+	var messages []string
+	for _, value := range fieldDiff.OldMetadata.EnumValues {
+		if !newValues[value] {
+			messages = append(messages, fmt.Sprintf(tmpl, field, value, resource))
+		}
+	}
+	return messages
+}
+
+var FieldNarrowingValidation = FieldDiffRule{
+	Identifier: "field-narrowing-validation",
+	Messages:   FieldNarrowingValidationMessages,
+}
+
+func FieldNarrowingValidationMessages(resource, field string, fieldDiff diff.FieldDiff) []string {
+	// ignore for added / removed fields and fields without metadata
+	if fieldDiff.OldMetadata == nil || fieldDiff.NewMetadata == nil {
+		return nil
+	}
+	oldConstraints := fieldDiff.OldMetadata.Constraints
+	newConstraints := fieldDiff.NewMetadata.Constraints
+	// Validation added to previously unvalidated fields isn't reported, as it can't be
+	// told apart from metadata that predates validation being recorded.
+	var messages []string
+	if boundNarrowed(oldConstraints.Min, newConstraints.Min, func(old, new float64) bool { return new > old }) {
+		tmpl := "Field `%s` minimum went from %s to %s on `%s`"
+		messages = append(messages, fmt.Sprintf(tmpl, field, oldConstraints.Min, newConstraints.Min, resource))
+	}
+	if boundNarrowed(oldConstraints.Max, newConstraints.Max, func(old, new float64) bool { return new < old }) {
+		tmpl := "Field `%s` maximum went from %s to %s on `%s`"
+		messages = append(messages, fmt.Sprintf(tmpl, field, oldConstraints.Max, newConstraints.Max, resource))
+	}
+	return messages
+}
+
+var FieldChangingValidationPattern = FieldDiffRule{
+	Identifier: "field-changing-validation-pattern",
+	Messages:   FieldChangingValidationPatternMessages,
+	Severity:   SeverityInformational,
+}
+
+// FieldChangingValidationPatternMessages reports changed validation patterns for review.
+// Whether a pattern accepts fewer values than before can't be decided by comparing them,
+// so changes aren't blocking.
+func FieldChangingValidationPatternMessages(resource, field string, fieldDiff diff.FieldDiff) []string {
+	// ignore for added / removed fields and fields without metadata
+	if fieldDiff.OldMetadata == nil || fieldDiff.NewMetadata == nil {
+		return nil
+	}
+	oldRegex := fieldDiff.OldMetadata.Constraints.ValidationRegex
+	newRegex := fieldDiff.NewMetadata.Constraints.ValidationRegex
+	tmpl := "Field `%s` validation pattern changed from `%s` to `%s` on `%s`"
+	if oldRegex != "" && newRegex != "" && oldRegex != newRegex {
+		return []string{fmt.Sprintf(tmpl, field, oldRegex, newRegex, resource)}
+	}
+	return nil
+}
+
+// boundNarrowed returns whether a numeric bound was narrowed. Removed bounds and bounds
+// that aren't numeric literals are never considered narrowed.
+func boundNarrowed(oldBound, newBound string, narrowed func(old, new float64) bool) bool {
+	oldValue, err := strconv.ParseFloat(oldBound, 64)
+	if err != nil {
+		return false
+	}
+	newValue, err := strconv.ParseFloat(newBound, 64)
+	if err != nil {
+		return false
+	}
+	return narrowed(oldValue, newValue)
+}
@@ -2,9 +2,12 @@ package breaking_changes
 
 import (
 	"regexp"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,10 +15,121 @@ type fieldTestCase struct {
 	name              string
 	oldField          *schema.Schema
 	newField          *schema.Schema
+	oldMetadata       *metadata.Field
+	newMetadata       *metadata.Field
 	expectedViolation bool
 	messageRegex      string // Optional regex to validate the message content
 }
 
+func TestRemovingAField(t *testing.T) {
+	for _, tc := range RemovingAFieldTestCases {
+		tc.check(RemovingAField, t)
+	}
+}
+
+var RemovingAFieldTestCases = []fieldTestCase{
+	{
+		name:              "control",
+		oldField:          &schema.Schema{Description: "beep", Optional: true},
+		newField:          &schema.Schema{Description: "beep", Optional: true},
+		expectedViolation: false,
+	},
+	{
+		name:              "adding a field",
+		newField:          &schema.Schema{Description: "beep", Optional: true},
+		expectedViolation: false,
+	},
+	{
+		name:              "removing a field",
+		oldField:          &schema.Schema{Description: "beep", Optional: true},
+		expectedViolation: true,
+		messageRegex:      "^Field `field` within resource `resource` was either removed or renamed without being deprecated first$",
+	},
+	{
+		name:              "removing a deprecated field",
+		oldField:          &schema.Schema{Description: "beep", Optional: true, Deprecated: "use `field_b` instead"},
+		expectedViolation: true,
+		messageRegex:      "^Field `field` within resource `resource` was either removed or renamed$",
+	},
+}
+
+func TestRemovingAFieldAcrossResource(t *testing.T) {
+	for _, tc := range RemovingAFieldResourceTestCases {
+		var gotMessages []string
+		for field, fieldDiff := range tc.resourceDiff.Fields {
+			gotMessages = append(gotMessages, RemovingAField.Messages("resource", field, fieldDiff)...)
+		}
+
+		if len(gotMessages) != len(tc.expectedFields) {
+			t.Errorf("RemovingAField(%v) got %d messages; want %d", tc.name, len(gotMessages), len(tc.expectedFields))
+			continue
+		}
+		wantFields := tc.expectedFields
+		sort.Strings(wantFields)
+		sort.Strings(gotMessages)
+		for i, field := range wantFields {
+			if !strings.Contains(gotMessages[i], field) {
+				t.Errorf("RemovingAField(%v) got message %q; want field %q", tc.name, gotMessages[i], field)
+			}
+		}
+	}
+}
+
+var RemovingAFieldResourceTestCases = []resourceSchemaTestCase{
+	{
+		name: "control",
+		resourceDiff: diff.ResourceDiff{
+			Fields: map[string]diff.FieldDiff{
+				"field-a": diff.FieldDiff{
+					Old: &schema.Schema{Description: "beep", Optional: true},
+					New: &schema.Schema{Description: "beep", Optional: true},
+				},
+			},
+		},
+		expectedFields: []string{},
+	},
+	{
+		name: "adding a field",
+		resourceDiff: diff.ResourceDiff{
+			Fields: map[string]diff.FieldDiff{
+				"field-a": diff.FieldDiff{
+					Old: nil,
+					New: &schema.Schema{Description: "beep", Optional: true},
+				},
+			},
+		},
+		expectedFields: []string{},
+	},
+	{
+		name: "removing a field",
+		resourceDiff: diff.ResourceDiff{
+			Fields: map[string]diff.FieldDiff{
+				"field-a": diff.FieldDiff{
+					Old: &schema.Schema{Description: "beep", Optional: true},
+					New: nil,
+				},
+			},
+		},
+		expectedFields: []string{"field-a"},
+	},
+	{
+		name: "removing multiple fields",
+		resourceDiff: diff.ResourceDiff{
+			Fields: map[string]diff.FieldDiff{
+				"field-a": diff.FieldDiff{
+					Old: &schema.Schema{Description: "beep", Optional: true},
+					New: nil,
+				},
+				"field-b": diff.FieldDiff{
+					Old: &schema.Schema{Description: "beep", Optional: true},
+					New: nil,
+				},
+			},
+		},
+		expectedFields: []string{"field-a", "field-b"},
+	},
+}
+
 func TestFieldBecomingRequired(t *testing.T) {
 	for _, tc := range FieldBecomingRequiredTestCases {
 		tc.check(FieldBecomingRequired, t)
@@ -592,9 +706,251 @@ var FieldShrinkingMaxTestCases = []fieldTestCase{
 	},
 }
 
+func TestFieldBecomingForceNew(t *testing.T) {
+	for _, tc := range FieldBecomingForceNewTestCases {
+		tc.check(FieldBecomingForceNew, t)
+	}
+}
+
+var FieldBecomingForceNewTestCases = []fieldTestCase{
+	{
+		name:              "control",
+		oldField:          &schema.Schema{Optional: true, ForceNew: true},
+		newField:          &schema.Schema{Optional: true, ForceNew: true},
+		expectedViolation: false,
+	},
+	{
+		name:              "becoming force new",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true, ForceNew: true},
+		expectedViolation: true,
+		messageRegex:      "Field `field` changed to ForceNew on `resource`",
+	},
+	{
+		name:              "no longer force new",
+		oldField:          &schema.Schema{Optional: true, ForceNew: true},
+		newField:          &schema.Schema{Optional: true},
+		expectedViolation: false,
+	},
+	{
+		name:              "force new field added",
+		newField:          &schema.Schema{Optional: true, ForceNew: true},
+		expectedViolation: false,
+	},
+}
+
+func TestFieldRemovingSensitive(t *testing.T) {
+	for _, tc := range FieldRemovingSensitiveTestCases {
+		tc.check(FieldRemovingSensitive, t)
+	}
+}
+
+var FieldRemovingSensitiveTestCases = []fieldTestCase{
+	{
+		name:              "control",
+		oldField:          &schema.Schema{Optional: true, Sensitive: true},
+		newField:          &schema.Schema{Optional: true, Sensitive: true},
+		expectedViolation: false,
+	},
+	{
+		name:              "removing sensitive",
+		oldField:          &schema.Schema{Optional: true, Sensitive: true},
+		newField:          &schema.Schema{Optional: true},
+		expectedViolation: true,
+		messageRegex:      "Field `field` is no longer sensitive on `resource`",
+	},
+	{
+		name:              "adding sensitive",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true, Sensitive: true},
+		expectedViolation: false,
+	},
+	{
+		name:              "sensitive field removed",
+		oldField:          &schema.Schema{Optional: true, Sensitive: true},
+		expectedViolation: false,
+	},
+}
+
+func TestFieldNewlyDeprecated(t *testing.T) {
+	for _, tc := range FieldNewlyDeprecatedTestCases {
+		tc.check(FieldNewlyDeprecated, t)
+	}
+}
+
+var FieldNewlyDeprecatedTestCases = []fieldTestCase{
+	{
+		name:              "control",
+		oldField:          &schema.Schema{Optional: true, Deprecated: "beep"},
+		newField:          &schema.Schema{Optional: true, Deprecated: "boop"},
+		expectedViolation: false,
+	},
+	{
+		name:              "field deprecated",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true, Deprecated: "beep"},
+		expectedViolation: true,
+		messageRegex:      "Field `field` within resource `resource` was deprecated",
+	},
+	{
+		name:              "deprecated field added",
+		newField:          &schema.Schema{Optional: true, Deprecated: "beep"},
+		expectedViolation: false,
+	},
+}
+
+func TestFieldRemovingEnumValue(t *testing.T) {
+	for _, tc := range FieldRemovingEnumValueTestCases {
+		tc.check(FieldRemovingEnumValue, t)
+	}
+}
+
+var FieldRemovingEnumValueTestCases = []fieldTestCase{
+	{
+		name:              "control",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{EnumValues: []string{"A", "B"}}},
+		newMetadata:       &metadata.Field{Constraints: metadata.Constraints{EnumValues: []string{"A", "B"}}},
+		expectedViolation: false,
+	},
+	{
+		name:              "enum value added",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{EnumValues: []string{"A"}}},
+		newMetadata:       &metadata.Field{Constraints: metadata.Constraints{EnumValues: []string{"A", "B"}}},
+		expectedViolation: false,
+	},
+	{
+		name:              "enum value removed",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{EnumValues: []string{"A", "B"}}},
+		newMetadata:       &metadata.Field{Constraints: metadata.Constraints{EnumValues: []string{"A"}}},
+		expectedViolation: true,
+		messageRegex:      "Field `field` no longer accepts value `B` on `resource`",
+	},
+	{
+		name:              "no longer an enum",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{EnumValues: []string{"A", "B"}}},
+		newMetadata:       &metadata.Field{},
+		expectedViolation: false,
+	},
+	{
+		name:              "no metadata",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		expectedViolation: false,
+	},
+}
+
+func TestFieldNarrowingValidation(t *testing.T) {
+	for _, tc := range FieldNarrowingValidationTestCases {
+		tc.check(FieldNarrowingValidation, t)
+	}
+}
+
+var FieldNarrowingValidationTestCases = []fieldTestCase{
+	{
+		name:              "control",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{ValidationRegex: "^[a-z]+$", Min: "0", Max: "10"}},
+		newMetadata:       &metadata.Field{Constraints: metadata.Constraints{ValidationRegex: "^[a-z]+$", Min: "0", Max: "10"}},
+		expectedViolation: false,
+	},
+	{
+		name:              "validation pattern changed",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{ValidationRegex: "^[a-z]+$"}},
+		newMetadata:       &metadata.Field{Constraints: metadata.Constraints{ValidationRegex: "^[a-z]{1,8}$"}},
+		expectedViolation: false,
+	},
+	{
+		name:              "minimum growing",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{Min: "0"}},
+		newMetadata:       &metadata.Field{Constraints: metadata.Constraints{Min: "1"}},
+		expectedViolation: true,
+		messageRegex:      "Field `field` minimum went from 0 to 1 on `resource`",
+	},
+	{
+		name:              "maximum shrinking",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{Max: "1024"}},
+		newMetadata:       &metadata.Field{Constraints: metadata.Constraints{Max: "64"}},
+		expectedViolation: true,
+		messageRegex:      "Field `field` maximum went from 1024 to 64 on `resource`",
+	},
+	{
+		name:              "bounds widening",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{Min: "1", Max: "64"}},
+		newMetadata:       &metadata.Field{Constraints: metadata.Constraints{Min: "0", Max: "1024"}},
+		expectedViolation: false,
+	},
+	{
+		name:              "bounds removed",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{Min: "1", Max: "64"}},
+		newMetadata:       &metadata.Field{},
+		expectedViolation: false,
+	},
+}
+
+func TestFieldChangingValidationPattern(t *testing.T) {
+	for _, tc := range FieldChangingValidationPatternTestCases {
+		tc.check(FieldChangingValidationPattern, t)
+	}
+}
+
+var FieldChangingValidationPatternTestCases = []fieldTestCase{
+	{
+		name:              "control",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{ValidationRegex: "^[a-z]+$"}},
+		newMetadata:       &metadata.Field{Constraints: metadata.Constraints{ValidationRegex: "^[a-z]+$"}},
+		expectedViolation: false,
+	},
+	{
+		name:              "validation pattern changed",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{ValidationRegex: "^[a-z]+$"}},
+		newMetadata:       &metadata.Field{Constraints: metadata.Constraints{ValidationRegex: "^[a-z]{1,8}$"}},
+		expectedViolation: true,
+		messageRegex:      "Field `field` validation pattern changed from `\\^\\[a-z\\]\\+\\$` to",
+	},
+	{
+		name:              "validation pattern added",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{},
+		newMetadata:       &metadata.Field{Constraints: metadata.Constraints{ValidationRegex: "^[a-z]+$"}},
+		expectedViolation: false,
+	},
+	{
+		name:              "validation pattern removed",
+		oldField:          &schema.Schema{Optional: true},
+		newField:          &schema.Schema{Optional: true},
+		oldMetadata:       &metadata.Field{Constraints: metadata.Constraints{ValidationRegex: "^[a-z]+$"}},
+		newMetadata:       &metadata.Field{},
+		expectedViolation: false,
+	},
+}
+
 // Extended check method that also validates message content when expected
 func (tc *fieldTestCase) check(rule FieldDiffRule, t *testing.T) {
-	messages := rule.Messages("resource", "field", diff.FieldDiff{Old: tc.oldField, New: tc.newField})
+	messages := rule.Messages("resource", "field", diff.FieldDiff{Old: tc.oldField, New: tc.newField, OldMetadata: tc.oldMetadata, NewMetadata: tc.newMetadata})
 	violation := len(messages) > 0
 
 	// Check violation expectation
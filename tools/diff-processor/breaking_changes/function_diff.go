@@ -0,0 +1,120 @@
+package breaking_changes
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// FunctionDiffRule provides structure for rules
+// regarding provider function signature changes
+type FunctionDiffRule struct {
+	Identifier string
+	Messages   func(function string, functionDiff diff.FunctionDiff) []string
+	Severity   Severity
+}
+
+// FunctionDiffRules is a list of FunctionDiffRule
+// guarding against provider function breaking changes
+var FunctionDiffRules = []FunctionDiffRule{
+	FunctionRemoval,
+	FunctionParameterChange,
+	FunctionReturnTypeChange,
+}
+
+var FunctionRemoval = FunctionDiffRule{
+	Identifier: "function-removal-or-rename",
+	Messages:   FunctionRemovalMessages,
+}
+
+func FunctionRemovalMessages(function string, functionDiff diff.FunctionDiff) []string {
+	if functionDiff.Old != nil && functionDiff.New == nil {
+		tmpl := "Function `%s` was either removed or renamed"
+		return []string{fmt.Sprintf(tmpl, function)}
+	}
+	return nil
+}
+
+var FunctionParameterChange = FunctionDiffRule{
+	Identifier: "function-parameter-change",
+	Messages:   FunctionParameterChangeMessages,
+}
+
+// FunctionParameterChangeMessages reports changes to the positional parameters of a
+// function that break existing calls: added or removed parameters, type changes and
+// parameters that no longer accept null or unknown values. Adding a variadic
+// parameter doesn't break existing calls, but changing or removing one does.
+func FunctionParameterChangeMessages(function string, functionDiff diff.FunctionDiff) []string {
+	if functionDiff.Old == nil || functionDiff.New == nil {
+		return nil
+	}
+	var messages []string
+	oldParameters := functionDiff.Old.Parameters
+	newParameters := functionDiff.New.Parameters
+	for i := len(oldParameters); i < len(newParameters); i++ {
+		tmpl := "Parameter `%s` was added to function `%s`"
+		messages = append(messages, fmt.Sprintf(tmpl, newParameters[i].Name, function))
+	}
+	for i := len(newParameters); i < len(oldParameters); i++ {
+		tmpl := "Parameter `%s` was removed from function `%s`"
+		messages = append(messages, fmt.Sprintf(tmpl, oldParameters[i].Name, function))
+	}
+	for i := 0; i < len(oldParameters) && i < len(newParameters); i++ {
+		messages = append(messages, parameterChangeMessages("Parameter", function, oldParameters[i], newParameters[i])...)
+	}
+
+	oldVariadic := functionDiff.Old.VariadicParameter
+	newVariadic := functionDiff.New.VariadicParameter
+	if oldVariadic != nil && newVariadic == nil {
+		tmpl := "Variadic parameter `%s` was removed from function `%s`"
+		messages = append(messages, fmt.Sprintf(tmpl, oldVariadic.Name, function))
+	}
+	if oldVariadic != nil && newVariadic != nil {
+		messages = append(messages, parameterChangeMessages("Variadic parameter", function, oldVariadic, newVariadic)...)
+	}
+	return messages
+}
+
+func parameterChangeMessages(kind, function string, oldParameter, newParameter *tfprotov5.FunctionParameter) []string {
+	var messages []string
+	if !diff.TypesEqual(oldParameter.Type, newParameter.Type) {
+		tmpl := "%s `%s` of function `%s` changed from %s to %s"
+		messages = append(messages, fmt.Sprintf(tmpl, kind, newParameter.Name, function, typeString(oldParameter.Type), typeString(newParameter.Type)))
+	}
+	if oldParameter.AllowNullValue && !newParameter.AllowNullValue {
+		tmpl := "%s `%s` of function `%s` no longer allows null values"
+		messages = append(messages, fmt.Sprintf(tmpl, kind, newParameter.Name, function))
+	}
+	if oldParameter.AllowUnknownValues && !newParameter.AllowUnknownValues {
+		tmpl := "%s `%s` of function `%s` no longer allows unknown values"
+		messages = append(messages, fmt.Sprintf(tmpl, kind, newParameter.Name, function))
+	}
+	return messages
+}
+
+var FunctionReturnTypeChange = FunctionDiffRule{
+	Identifier: "function-return-type-change",
+	Messages:   FunctionReturnTypeChangeMessages,
+}
+
+func FunctionReturnTypeChangeMessages(function string, functionDiff diff.FunctionDiff) []string {
+	if functionDiff.Old == nil || functionDiff.New == nil {
+		return nil
+	}
+	oldType := returnType(functionDiff.Old)
+	newType := returnType(functionDiff.New)
+	if !diff.TypesEqual(oldType, newType) {
+		tmpl := "Return type of function `%s` changed from %s to %s"
+		return []string{fmt.Sprintf(tmpl, function, typeString(oldType), typeString(newType))}
+	}
+	return nil
+}
+
+func returnType(function *tfprotov5.Function) tftypes.Type {
+	if function.Return == nil {
+		return nil
+	}
+	return function.Return.Type
+}
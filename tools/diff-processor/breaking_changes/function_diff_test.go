@@ -0,0 +1,118 @@
+package breaking_changes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+)
+
+type functionTestCase struct {
+	name         string
+	oldFunction  *tfprotov5.Function
+	newFunction  *tfprotov5.Function
+	wantMessages []string
+}
+
+func stringFunction(parameters ...*tfprotov5.FunctionParameter) *tfprotov5.Function {
+	return &tfprotov5.Function{
+		Parameters: parameters,
+		Return:     &tfprotov5.FunctionReturn{Type: tftypes.String},
+	}
+}
+
+func TestFunctionRemoval(t *testing.T) {
+	checkFunctionRule(t, FunctionRemoval, []functionTestCase{
+		{
+			name:        "function added",
+			newFunction: stringFunction(),
+		},
+		{
+			name:         "function removed",
+			oldFunction:  stringFunction(),
+			wantMessages: []string{"Function `f` was either removed or renamed"},
+		},
+	})
+}
+
+func TestFunctionParameterChange(t *testing.T) {
+	self := &tfprotov5.FunctionParameter{Name: "self_link", Type: tftypes.String}
+	checkFunctionRule(t, FunctionParameterChange, []functionTestCase{
+		{
+			name:        "control",
+			oldFunction: stringFunction(self),
+			newFunction: stringFunction(self),
+		},
+		{
+			name:         "parameter added",
+			oldFunction:  stringFunction(self),
+			newFunction:  stringFunction(self, &tfprotov5.FunctionParameter{Name: "region", Type: tftypes.String}),
+			wantMessages: []string{"Parameter `region` was added to function `f`"},
+		},
+		{
+			name:         "parameter removed",
+			oldFunction:  stringFunction(self),
+			newFunction:  stringFunction(),
+			wantMessages: []string{"Parameter `self_link` was removed from function `f`"},
+		},
+		{
+			name:         "parameter type changed",
+			oldFunction:  stringFunction(self),
+			newFunction:  stringFunction(&tfprotov5.FunctionParameter{Name: "self_link", Type: tftypes.List{ElementType: tftypes.String}}),
+			wantMessages: []string{"Parameter `self_link` of function `f` changed from tftypes.String to tftypes.List[tftypes.String]"},
+		},
+		{
+			name:         "parameter no longer nullable",
+			oldFunction:  stringFunction(&tfprotov5.FunctionParameter{Name: "self_link", Type: tftypes.String, AllowNullValue: true}),
+			newFunction:  stringFunction(self),
+			wantMessages: []string{"Parameter `self_link` of function `f` no longer allows null values"},
+		},
+		{
+			name:        "variadic parameter added",
+			oldFunction: stringFunction(self),
+			newFunction: &tfprotov5.Function{
+				Parameters:        []*tfprotov5.FunctionParameter{self},
+				VariadicParameter: &tfprotov5.FunctionParameter{Name: "parts", Type: tftypes.String},
+				Return:            &tfprotov5.FunctionReturn{Type: tftypes.String},
+			},
+		},
+		{
+			name: "variadic parameter removed",
+			oldFunction: &tfprotov5.Function{
+				Parameters:        []*tfprotov5.FunctionParameter{self},
+				VariadicParameter: &tfprotov5.FunctionParameter{Name: "parts", Type: tftypes.String},
+				Return:            &tfprotov5.FunctionReturn{Type: tftypes.String},
+			},
+			newFunction:  stringFunction(self),
+			wantMessages: []string{"Variadic parameter `parts` was removed from function `f`"},
+		},
+	})
+}
+
+func TestFunctionReturnTypeChange(t *testing.T) {
+	checkFunctionRule(t, FunctionReturnTypeChange, []functionTestCase{
+		{
+			name:        "control",
+			oldFunction: stringFunction(),
+			newFunction: stringFunction(),
+		},
+		{
+			name:         "return type changed",
+			oldFunction:  stringFunction(),
+			newFunction:  &tfprotov5.Function{Return: &tfprotov5.FunctionReturn{Type: tftypes.Number}},
+			wantMessages: []string{"Return type of function `f` changed from tftypes.String to tftypes.Number"},
+		},
+	})
+}
+
+func checkFunctionRule(t *testing.T, rule FunctionDiffRule, cases []functionTestCase) {
+	for _, tc := range cases {
+		got := rule.Messages("f", diff.FunctionDiff{Old: tc.oldFunction, New: tc.newFunction})
+		if diff := cmp.Diff(tc.wantMessages, got); diff != "" {
+			t.Errorf("Test `%s` failed: messages diff(-want, +got) = %s", tc.name, diff)
+		}
+	}
+}
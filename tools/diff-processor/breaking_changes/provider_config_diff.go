@@ -0,0 +1,66 @@
+package breaking_changes
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+)
+
+// ProviderConfigDiffRule provides structure for rules
+// regarding provider configuration field changes
+type ProviderConfigDiffRule struct {
+	Identifier string
+	Messages   func(field string, fieldDiff diff.FieldDiff) []string
+	Severity   Severity
+}
+
+// ProviderConfigDiffRules is a list of ProviderConfigDiffRule
+// guarding against provider configuration breaking changes
+var ProviderConfigDiffRules = []ProviderConfigDiffRule{
+	ProviderConfigFieldRemoval,
+	ProviderConfigFieldBecomingRequired,
+	ProviderConfigFieldChangingType,
+}
+
+var ProviderConfigFieldRemoval = ProviderConfigDiffRule{
+	Identifier: "provider-config-field-removal-or-rename",
+	Messages:   ProviderConfigFieldRemovalMessages,
+}
+
+func ProviderConfigFieldRemovalMessages(field string, fieldDiff diff.FieldDiff) []string {
+	if fieldDiff.Old != nil && fieldDiff.New == nil {
+		tmpl := "Provider field `%s` was either removed or renamed"
+		return []string{fmt.Sprintf(tmpl, field)}
+	}
+	return nil
+}
+
+var ProviderConfigFieldBecomingRequired = ProviderConfigDiffRule{
+	Identifier: "provider-config-field-optional-to-required",
+	Messages:   ProviderConfigFieldBecomingRequiredMessages,
+}
+
+func ProviderConfigFieldBecomingRequiredMessages(field string, fieldDiff diff.FieldDiff) []string {
+	// A new required field breaks every existing provider block
+	if fieldDiff.New == nil || !fieldDiff.New.Required {
+		return nil
+	}
+	if fieldDiff.Old == nil || !fieldDiff.Old.Required {
+		tmpl := "Provider field `%s` is now required"
+		return []string{fmt.Sprintf(tmpl, field)}
+	}
+	return nil
+}
+
+var ProviderConfigFieldChangingType = ProviderConfigDiffRule{
+	Identifier: "provider-config-field-changing-type",
+	Messages:   ProviderConfigFieldChangingTypeMessages,
+}
+
+func ProviderConfigFieldChangingTypeMessages(field string, fieldDiff diff.FieldDiff) []string {
+	if oldType, newType, changed := fieldTypeChange(fieldDiff); changed {
+		tmpl := "Provider field `%s` changed from %s to %s"
+		return []string{fmt.Sprintf(tmpl, field, oldType, newType)}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package breaking_changes
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+)
+
+func TestProviderConfigFieldRemoval(t *testing.T) {
+	checkProviderConfigRule(t, ProviderConfigFieldRemoval, []fieldTestCase{
+		{
+			name:              "control",
+			oldField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+			newField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+			expectedViolation: false,
+		},
+		{
+			name:              "field added",
+			newField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+			expectedViolation: false,
+		},
+		{
+			name:              "field removed",
+			oldField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+			expectedViolation: true,
+			messageRegex:      "Provider field `field` was either removed or renamed",
+		},
+	})
+}
+
+func TestProviderConfigFieldBecomingRequired(t *testing.T) {
+	checkProviderConfigRule(t, ProviderConfigFieldBecomingRequired, []fieldTestCase{
+		{
+			name:              "control",
+			oldField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+			newField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+			expectedViolation: false,
+		},
+		{
+			name:              "optional to required",
+			oldField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+			newField:          &schema.Schema{Type: schema.TypeString, Required: true},
+			expectedViolation: true,
+			messageRegex:      "Provider field `field` is now required",
+		},
+		{
+			name:              "required field added",
+			newField:          &schema.Schema{Type: schema.TypeString, Required: true},
+			expectedViolation: true,
+		},
+		{
+			name:              "field removed",
+			oldField:          &schema.Schema{Type: schema.TypeString, Required: true},
+			expectedViolation: false,
+		},
+	})
+}
+
+func TestProviderConfigFieldChangingType(t *testing.T) {
+	checkProviderConfigRule(t, ProviderConfigFieldChangingType, []fieldTestCase{
+		{
+			name:              "control",
+			oldField:          &schema.Schema{Type: schema.TypeBool, Optional: true},
+			newField:          &schema.Schema{Type: schema.TypeBool, Optional: true},
+			expectedViolation: false,
+		},
+		{
+			name:              "type changed",
+			oldField:          &schema.Schema{Type: schema.TypeBool, Optional: true},
+			newField:          &schema.Schema{Type: schema.TypeString, Optional: true},
+			expectedViolation: true,
+			messageRegex:      "Provider field `field` changed from TypeBool to TypeString",
+		},
+	})
+}
+
+func checkProviderConfigRule(t *testing.T, rule ProviderConfigDiffRule, cases []fieldTestCase) {
+	for _, tc := range cases {
+		messages := rule.Messages("field", diff.FieldDiff{Old: tc.oldField, New: tc.newField})
+		if violation := len(messages) > 0; tc.expectedViolation != violation {
+			t.Errorf("Test `%s` failed: expected %v violations, got %v", tc.name, tc.expectedViolation, violation)
+			continue
+		}
+		if tc.messageRegex != "" && len(messages) > 0 && !regexp.MustCompile(tc.messageRegex).MatchString(messages[0]) {
+			t.Errorf("Test `%s` failed: message didn't match expected pattern '%s'. Got messages: %v", tc.name, tc.messageRegex, messages)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package breaking_changes
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
 )
@@ -11,11 +12,20 @@ import (
 type ResourceConfigDiffRule struct {
 	Identifier string
 	Messages   func(resource string, resourceConfigDiff diff.ResourceConfigDiff) []string
+	Severity   Severity
 }
 
 // ResourceConfigDiffRules is a list of ResourceConfigDiffRule
 // guarding against provider breaking changes
-var ResourceConfigDiffRules = []ResourceConfigDiffRule{ResourceConfigRemovingAResource}
+var ResourceConfigDiffRules = []ResourceConfigDiffRule{
+	ResourceConfigRemovingAResource,
+	ResourceConfigNewlyDeprecated,
+	ResourceConfigShorteningTimeouts,
+	ResourceConfigRemovingImporter,
+	ResourceConfigSchemaVersionWithoutStateUpgrader,
+	ResourceConfigChangingIdFormat,
+	ResourceConfigRemovingImportFormat,
+}
 
 var ResourceConfigRemovingAResource = ResourceConfigDiffRule{
 	Identifier: "resource-map-resource-removal-or-rename",
@@ -29,3 +39,153 @@ func ResourceConfigRemovingAResourceMessages(resource string, resourceConfigDiff
 	}
 	return nil
 }
+
+var ResourceConfigNewlyDeprecated = ResourceConfigDiffRule{
+	Identifier: "resource-deprecation",
+	Messages:   ResourceConfigNewlyDeprecatedMessages,
+	Severity:   SeverityInformational,
+}
+
+func ResourceConfigNewlyDeprecatedMessages(resource string, resourceConfigDiff diff.ResourceConfigDiff) []string {
+	if resourceConfigDiff.Old == nil || resourceConfigDiff.New == nil {
+		return nil
+	}
+	if resourceConfigDiff.Old.DeprecationMessage == "" && resourceConfigDiff.New.DeprecationMessage != "" {
+		tmpl := "Resource `%s` was deprecated"
+		return []string{fmt.Sprintf(tmpl, resource)}
+	}
+	return nil
+}
+
+var ResourceConfigShorteningTimeouts = ResourceConfigDiffRule{
+	Identifier: "resource-timeouts-removal-or-decrease",
+	Messages:   ResourceConfigShorteningTimeoutsMessages,
+}
+
+func ResourceConfigShorteningTimeoutsMessages(resource string, resourceConfigDiff diff.ResourceConfigDiff) []string {
+	if resourceConfigDiff.Old == nil || resourceConfigDiff.New == nil || resourceConfigDiff.Old.Timeouts == nil {
+		return nil
+	}
+	if resourceConfigDiff.New.Timeouts == nil {
+		tmpl := "Resource `%s` no longer supports configuring timeouts"
+		return []string{fmt.Sprintf(tmpl, resource)}
+	}
+	oldTimeouts := resourceConfigDiff.Old.Timeouts
+	newTimeouts := resourceConfigDiff.New.Timeouts
+	timeouts := []struct {
+		name     string
+		old, new *time.Duration
+	}{
+		{"create", oldTimeouts.Create, newTimeouts.Create},
+		{"read", oldTimeouts.Read, newTimeouts.Read},
+		{"update", oldTimeouts.Update, newTimeouts.Update},
+		{"delete", oldTimeouts.Delete, newTimeouts.Delete},
+		{"default", oldTimeouts.Default, newTimeouts.Default},
+	}
+	var messages []string
+	for _, timeout := range timeouts {
+		if timeout.old == nil {
+			continue
+		}
+		if timeout.new == nil {
+			tmpl := "Resource `%s` no longer supports configuring the `%s` timeout"
+			messages = append(messages, fmt.Sprintf(tmpl, resource, timeout.name))
+		} else if *timeout.new < *timeout.old {
+			tmpl := "Default `%s` timeout of resource `%s` decreased from %s to %s"
+			messages = append(messages, fmt.Sprintf(tmpl, timeout.name, resource, timeout.old, timeout.new))
+		}
+	}
+	return messages
+}
+
+var ResourceConfigRemovingImporter = ResourceConfigDiffRule{
+	Identifier: "resource-import-removal",
+	Messages:   ResourceConfigRemovingImporterMessages,
+}
+
+func ResourceConfigRemovingImporterMessages(resource string, resourceConfigDiff diff.ResourceConfigDiff) []string {
+	if resourceConfigDiff.Old == nil || resourceConfigDiff.New == nil {
+		return nil
+	}
+	if resourceConfigDiff.Old.Importer != nil && resourceConfigDiff.New.Importer == nil {
+		tmpl := "Resource `%s` can no longer be imported"
+		return []string{fmt.Sprintf(tmpl, resource)}
+	}
+	return nil
+}
+
+var ResourceConfigSchemaVersionWithoutStateUpgrader = ResourceConfigDiffRule{
+	Identifier: "resource-schema-version-without-state-upgrader",
+	Messages:   ResourceConfigSchemaVersionWithoutStateUpgraderMessages,
+}
+
+func ResourceConfigSchemaVersionWithoutStateUpgraderMessages(resource string, resourceConfigDiff diff.ResourceConfigDiff) []string {
+	if resourceConfigDiff.Old == nil || resourceConfigDiff.New == nil {
+		return nil
+	}
+	// Only SDKv2 resources upgrade state through StateUpgraders; the protocol schema
+	// of plugin framework resources doesn't record how they upgrade state.
+	if resourceConfigDiff.OldFromProtocol || resourceConfigDiff.NewFromProtocol {
+		return nil
+	}
+	upgraded := make(map[int]bool)
+	for _, upgrader := range resourceConfigDiff.New.StateUpgraders {
+		upgraded[upgrader.Version] = true
+	}
+	// State stored at any version between the old and new schema versions needs
+	// to be upgraded.
+	tmpl := "Resource `%s` schema version went from %d to %d without a state upgrader for version %d"
+	var messages []string
+	for version := resourceConfigDiff.Old.SchemaVersion; version < resourceConfigDiff.New.SchemaVersion; version++ {
+		if !upgraded[version] {
+			messages = append(messages, fmt.Sprintf(tmpl, resource, resourceConfigDiff.Old.SchemaVersion, resourceConfigDiff.New.SchemaVersion, version))
+		}
+	}
+	return messages
+}
+
+var ResourceConfigChangingIdFormat = ResourceConfigDiffRule{
+	Identifier: "resource-id",
+	Messages:   ResourceConfigChangingIdFormatMessages,
+}
+
+func ResourceConfigChangingIdFormatMessages(resource string, resourceConfigDiff diff.ResourceConfigDiff) []string {
+	oldMetadata := resourceConfigDiff.OldMetadata
+	newMetadata := resourceConfigDiff.NewMetadata
+	// Metadata without an ID format predates it being recorded.
+	if oldMetadata == nil || newMetadata == nil || oldMetadata.IdFormat == "" || newMetadata.IdFormat == "" {
+		return nil
+	}
+	if oldMetadata.IdFormat != newMetadata.IdFormat {
+		tmpl := "Resource `%s` ID format changed from `%s` to `%s`"
+		return []string{fmt.Sprintf(tmpl, resource, oldMetadata.IdFormat, newMetadata.IdFormat)}
+	}
+	return nil
+}
+
+var ResourceConfigRemovingImportFormat = ResourceConfigDiffRule{
+	Identifier: "resource-import-format",
+	Messages:   ResourceConfigRemovingImportFormatMessages,
+}
+
+func ResourceConfigRemovingImportFormatMessages(resource string, resourceConfigDiff diff.ResourceConfigDiff) []string {
+	oldMetadata := resourceConfigDiff.OldMetadata
+	newMetadata := resourceConfigDiff.NewMetadata
+	// Resources without import formats either use a custom import or predate them
+	// being recorded.
+	if oldMetadata == nil || newMetadata == nil || len(newMetadata.ImportFormats) == 0 {
+		return nil
+	}
+	newFormats := make(map[string]bool)
+	for _, format := range newMetadata.ImportFormats {
+		newFormats[format] = true
+	}
+	tmpl := "Import format `%s` was removed from resource `%s`"
+	var messages []string
+	for _, format := range oldMetadata.ImportFormats {
+		if !newFormats[format] {
+			messages = append(messages, fmt.Sprintf(tmpl, format, resource))
+		}
+	}
+	return messages
+}
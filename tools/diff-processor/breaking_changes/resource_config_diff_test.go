@@ -2,10 +2,12 @@ package breaking_changes
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
 )
 
 type resourceInventoryTestCase struct {
@@ -45,3 +47,269 @@ var resourceConfigRemovingAResourceTestCases = []resourceInventoryTestCase{
 		wantViolations: true,
 	},
 }
+
+func TestResourceConfigNewlyDeprecated(t *testing.T) {
+	for _, tc := range resourceConfigNewlyDeprecatedTestCases {
+		got := ResourceConfigNewlyDeprecated.Messages("resource", diff.ResourceConfigDiff{Old: tc.old, New: tc.new})
+		gotViolations := len(got) > 0
+		if tc.wantViolations != gotViolations {
+			t.Errorf("ResourceConfigNewlyDeprecated.Messages(%v) violations not expected. Got %v, want %v", tc.name, gotViolations, tc.wantViolations)
+		}
+	}
+}
+
+var resourceConfigNewlyDeprecatedTestCases = []resourceInventoryTestCase{
+	{
+		name:           "control",
+		old:            &schema.Resource{},
+		new:            &schema.Resource{},
+		wantViolations: false,
+	},
+	{
+		name:           "resource deprecated",
+		old:            &schema.Resource{},
+		new:            &schema.Resource{DeprecationMessage: "beep"},
+		wantViolations: true,
+	},
+	{
+		name:           "deprecated resource added",
+		old:            nil,
+		new:            &schema.Resource{DeprecationMessage: "beep"},
+		wantViolations: false,
+	},
+	{
+		name:           "deprecated resource removed",
+		old:            &schema.Resource{DeprecationMessage: "beep"},
+		new:            nil,
+		wantViolations: false,
+	},
+}
+
+func TestResourceConfigShorteningTimeouts(t *testing.T) {
+	for _, tc := range resourceConfigShorteningTimeoutsTestCases {
+		got := ResourceConfigShorteningTimeouts.Messages("resource", diff.ResourceConfigDiff{Old: tc.old, New: tc.new})
+		gotViolations := len(got) > 0
+		if tc.wantViolations != gotViolations {
+			t.Errorf("ResourceConfigShorteningTimeouts.Messages(%v) violations not expected. Got %v, want %v", tc.name, gotViolations, tc.wantViolations)
+		}
+	}
+}
+
+var resourceConfigShorteningTimeoutsTestCases = []resourceInventoryTestCase{
+	{
+		name:           "control",
+		old:            &schema.Resource{Timeouts: &schema.ResourceTimeout{Create: schema.DefaultTimeout(20 * time.Minute)}},
+		new:            &schema.Resource{Timeouts: &schema.ResourceTimeout{Create: schema.DefaultTimeout(20 * time.Minute)}},
+		wantViolations: false,
+	},
+	{
+		name:           "timeout increased",
+		old:            &schema.Resource{Timeouts: &schema.ResourceTimeout{Create: schema.DefaultTimeout(20 * time.Minute)}},
+		new:            &schema.Resource{Timeouts: &schema.ResourceTimeout{Create: schema.DefaultTimeout(30 * time.Minute)}},
+		wantViolations: false,
+	},
+	{
+		name:           "timeouts added",
+		old:            &schema.Resource{},
+		new:            &schema.Resource{Timeouts: &schema.ResourceTimeout{Create: schema.DefaultTimeout(20 * time.Minute)}},
+		wantViolations: false,
+	},
+	{
+		name:           "timeout decreased",
+		old:            &schema.Resource{Timeouts: &schema.ResourceTimeout{Create: schema.DefaultTimeout(20 * time.Minute)}},
+		new:            &schema.Resource{Timeouts: &schema.ResourceTimeout{Create: schema.DefaultTimeout(10 * time.Minute)}},
+		wantViolations: true,
+	},
+	{
+		name:           "timeout removed",
+		old:            &schema.Resource{Timeouts: &schema.ResourceTimeout{Create: schema.DefaultTimeout(20 * time.Minute), Delete: schema.DefaultTimeout(20 * time.Minute)}},
+		new:            &schema.Resource{Timeouts: &schema.ResourceTimeout{Create: schema.DefaultTimeout(20 * time.Minute)}},
+		wantViolations: true,
+	},
+	{
+		name:           "timeouts removed",
+		old:            &schema.Resource{Timeouts: &schema.ResourceTimeout{Create: schema.DefaultTimeout(20 * time.Minute)}},
+		new:            &schema.Resource{},
+		wantViolations: true,
+	},
+}
+
+func TestResourceConfigRemovingImporter(t *testing.T) {
+	for _, tc := range resourceConfigRemovingImporterTestCases {
+		got := ResourceConfigRemovingImporter.Messages("resource", diff.ResourceConfigDiff{Old: tc.old, New: tc.new})
+		gotViolations := len(got) > 0
+		if tc.wantViolations != gotViolations {
+			t.Errorf("ResourceConfigRemovingImporter.Messages(%v) violations not expected. Got %v, want %v", tc.name, gotViolations, tc.wantViolations)
+		}
+	}
+}
+
+var resourceConfigRemovingImporterTestCases = []resourceInventoryTestCase{
+	{
+		name:           "control",
+		old:            &schema.Resource{Importer: &schema.ResourceImporter{}},
+		new:            &schema.Resource{Importer: &schema.ResourceImporter{}},
+		wantViolations: false,
+	},
+	{
+		name:           "importer added",
+		old:            &schema.Resource{},
+		new:            &schema.Resource{Importer: &schema.ResourceImporter{}},
+		wantViolations: false,
+	},
+	{
+		name:           "importer removed",
+		old:            &schema.Resource{Importer: &schema.ResourceImporter{}},
+		new:            &schema.Resource{},
+		wantViolations: true,
+	},
+	{
+		name:           "importable resource removed",
+		old:            &schema.Resource{Importer: &schema.ResourceImporter{}},
+		new:            nil,
+		wantViolations: false,
+	},
+}
+
+func TestResourceConfigSchemaVersionWithoutStateUpgrader(t *testing.T) {
+	for _, tc := range resourceConfigSchemaVersionWithoutStateUpgraderTestCases {
+		got := ResourceConfigSchemaVersionWithoutStateUpgrader.Messages("resource", diff.ResourceConfigDiff{Old: tc.old, New: tc.new})
+		gotViolations := len(got) > 0
+		if tc.wantViolations != gotViolations {
+			t.Errorf("ResourceConfigSchemaVersionWithoutStateUpgrader.Messages(%v) violations not expected. Got %v, want %v", tc.name, gotViolations, tc.wantViolations)
+		}
+	}
+}
+
+var resourceConfigSchemaVersionWithoutStateUpgraderTestCases = []resourceInventoryTestCase{
+	{
+		name:           "control",
+		old:            &schema.Resource{SchemaVersion: 1, StateUpgraders: []schema.StateUpgrader{{Version: 0}}},
+		new:            &schema.Resource{SchemaVersion: 1, StateUpgraders: []schema.StateUpgrader{{Version: 0}}},
+		wantViolations: false,
+	},
+	{
+		name:           "schema version bumped with state upgrader",
+		old:            &schema.Resource{},
+		new:            &schema.Resource{SchemaVersion: 1, StateUpgraders: []schema.StateUpgrader{{Version: 0}}},
+		wantViolations: false,
+	},
+	{
+		name:           "schema version bumped without state upgrader",
+		old:            &schema.Resource{},
+		new:            &schema.Resource{SchemaVersion: 1},
+		wantViolations: true,
+	},
+	{
+		name:           "schema version bumped twice with one state upgrader",
+		old:            &schema.Resource{SchemaVersion: 1, StateUpgraders: []schema.StateUpgrader{{Version: 0}}},
+		new:            &schema.Resource{SchemaVersion: 3, StateUpgraders: []schema.StateUpgrader{{Version: 0}, {Version: 2}}},
+		wantViolations: true,
+	},
+}
+
+func TestResourceConfigSchemaVersionWithoutStateUpgrader_fromProtocol(t *testing.T) {
+	// Plugin framework resources converted from the protocol schema have a schema
+	// version but never any state upgraders.
+	cases := []struct {
+		name            string
+		oldFromProtocol bool
+		newFromProtocol bool
+	}{
+		{name: "framework resource", oldFromProtocol: true, newFromProtocol: true},
+		{name: "migrated to the framework", newFromProtocol: true},
+		{name: "migrated from the framework", oldFromProtocol: true},
+	}
+	for _, tc := range cases {
+		got := ResourceConfigSchemaVersionWithoutStateUpgrader.Messages("resource", diff.ResourceConfigDiff{
+			Old:             &schema.Resource{},
+			New:             &schema.Resource{SchemaVersion: 1},
+			OldFromProtocol: tc.oldFromProtocol,
+			NewFromProtocol: tc.newFromProtocol,
+		})
+		if len(got) > 0 {
+			t.Errorf("ResourceConfigSchemaVersionWithoutStateUpgrader.Messages(%v) = %v, want no violations", tc.name, got)
+		}
+	}
+}
+
+type resourceMetadataTestCase struct {
+	name           string
+	old            *metadata.Resource
+	new            *metadata.Resource
+	wantViolations bool
+}
+
+func TestResourceConfigChangingIdFormat(t *testing.T) {
+	for _, tc := range resourceConfigChangingIdFormatTestCases {
+		got := ResourceConfigChangingIdFormat.Messages("resource", diff.ResourceConfigDiff{OldMetadata: tc.old, NewMetadata: tc.new})
+		gotViolations := len(got) > 0
+		if tc.wantViolations != gotViolations {
+			t.Errorf("ResourceConfigChangingIdFormat.Messages(%v) violations not expected. Got %v, want %v", tc.name, gotViolations, tc.wantViolations)
+		}
+	}
+}
+
+var resourceConfigChangingIdFormatTestCases = []resourceMetadataTestCase{
+	{
+		name:           "control",
+		old:            &metadata.Resource{IdFormat: "projects/{{project}}/topics/{{name}}"},
+		new:            &metadata.Resource{IdFormat: "projects/{{project}}/topics/{{name}}"},
+		wantViolations: false,
+	},
+	{
+		name:           "no metadata",
+		old:            nil,
+		new:            &metadata.Resource{IdFormat: "projects/{{project}}/topics/{{name}}"},
+		wantViolations: false,
+	},
+	{
+		name:           "id format recorded",
+		old:            &metadata.Resource{},
+		new:            &metadata.Resource{IdFormat: "projects/{{project}}/topics/{{name}}"},
+		wantViolations: false,
+	},
+	{
+		name:           "id format changed",
+		old:            &metadata.Resource{IdFormat: "projects/{{project}}/topics/{{name}}"},
+		new:            &metadata.Resource{IdFormat: "{{project}}/{{name}}"},
+		wantViolations: true,
+	},
+}
+
+func TestResourceConfigRemovingImportFormat(t *testing.T) {
+	for _, tc := range resourceConfigRemovingImportFormatTestCases {
+		got := ResourceConfigRemovingImportFormat.Messages("resource", diff.ResourceConfigDiff{OldMetadata: tc.old, NewMetadata: tc.new})
+		gotViolations := len(got) > 0
+		if tc.wantViolations != gotViolations {
+			t.Errorf("ResourceConfigRemovingImportFormat.Messages(%v) violations not expected. Got %v, want %v", tc.name, gotViolations, tc.wantViolations)
+		}
+	}
+}
+
+var resourceConfigRemovingImportFormatTestCases = []resourceMetadataTestCase{
+	{
+		name:           "control",
+		old:            &metadata.Resource{ImportFormats: []string{"projects/{{project}}/topics/{{name}}", "{{name}}"}},
+		new:            &metadata.Resource{ImportFormats: []string{"projects/{{project}}/topics/{{name}}", "{{name}}"}},
+		wantViolations: false,
+	},
+	{
+		name:           "import format added",
+		old:            &metadata.Resource{ImportFormats: []string{"{{name}}"}},
+		new:            &metadata.Resource{ImportFormats: []string{"projects/{{project}}/topics/{{name}}", "{{name}}"}},
+		wantViolations: false,
+	},
+	{
+		name:           "import formats no longer recorded",
+		old:            &metadata.Resource{ImportFormats: []string{"{{name}}"}},
+		new:            &metadata.Resource{},
+		wantViolations: false,
+	},
+	{
+		name:           "import format removed",
+		old:            &metadata.Resource{ImportFormats: []string{"projects/{{project}}/topics/{{name}}", "{{name}}"}},
+		new:            &metadata.Resource{ImportFormats: []string{"projects/{{project}}/topics/{{name}}"}},
+		wantViolations: true,
+	},
+}
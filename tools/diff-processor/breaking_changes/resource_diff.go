@@ -10,32 +10,11 @@ import (
 type ResourceDiffRule struct {
 	Identifier string
 	Messages   func(resource string, resourceDiff diff.ResourceDiff) []string
+	Severity   Severity
 }
 
 // ResourceDiffRules is a list of all ResourceDiff rules
-var ResourceDiffRules = []ResourceDiffRule{RemovingAField, AddingExactlyOneOf}
-
-var RemovingAField = ResourceDiffRule{
-	Identifier: "resource-schema-field-removal-or-rename",
-	Messages:   RemovingAFieldMessages,
-}
-
-// TODO: Make field removal a FieldDiffRule b/300124253
-func RemovingAFieldMessages(resource string, resourceDiff diff.ResourceDiff) []string {
-	fieldsRemoved := []string{}
-	for field, fieldDiff := range resourceDiff.Fields {
-		if fieldDiff.Old != nil && fieldDiff.New == nil {
-			fieldsRemoved = append(fieldsRemoved, field)
-		}
-	}
-
-	tmpl := "Field `%s` within resource `%s` was either removed or renamed"
-	var messages []string
-	for _, field := range fieldsRemoved {
-		messages = append(messages, fmt.Sprintf(tmpl, field, resource))
-	}
-	return messages
-}
+var ResourceDiffRules = []ResourceDiffRule{AddingExactlyOneOf}
 
 var AddingExactlyOneOf = ResourceDiffRule{
 	Identifier: "resource-schema-field-addition-of-exactly-one-of",
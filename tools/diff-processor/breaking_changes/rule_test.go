@@ -64,5 +64,21 @@ func getArrayOfIdentifiers() []string {
 		identifiers = append(identifiers, r.Identifier)
 	}
 
+	for _, r := range DataSourceConfigDiffRules {
+		identifiers = append(identifiers, r.Identifier)
+	}
+
+	for _, r := range DataSourceFieldDiffRules {
+		identifiers = append(identifiers, r.Identifier)
+	}
+
+	for _, r := range ProviderConfigDiffRules {
+		identifiers = append(identifiers, r.Identifier)
+	}
+
+	for _, r := range FunctionDiffRules {
+		identifiers = append(identifiers, r.Identifier)
+	}
+
 	return identifiers
 }
@@ -1,6 +1,7 @@
 package breaking_changes
 
 import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,3 +24,10 @@ func getValueType(valueType schema.ValueType) string {
 	}
 	return "TypeUndefined"
 }
+
+func typeString(t tftypes.Type) string {
+	if t == nil {
+		return "TypeUndefined"
+	}
+	return t.String()
+}
@@ -7,6 +7,8 @@ import (
 	"io"
 	"os"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/breaking_changes"
 	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
@@ -17,17 +19,24 @@ const breakingChangesDesc = `Check for breaking changes between the new / old Te
 
 type breakingChangesOptions struct {
 	rootOptions       *rootOptions
-	computeSchemaDiff func() diff.SchemaDiff
+	computeSchemaDiff func() diff.ProviderSchemaDiff
 	stdout            io.Writer
+	stderr            io.Writer
+	now               func() time.Time
+
+	exemptionsFile string
+	majorVersion   int
 }
 
 func newBreakingChangesCmd(rootOptions *rootOptions) *cobra.Command {
 	o := &breakingChangesOptions{
 		rootOptions: rootOptions,
-		computeSchemaDiff: func() diff.SchemaDiff {
-			return schemaDiff
+		computeSchemaDiff: func() diff.ProviderSchemaDiff {
+			return rootOptions.providerSchemaDiff()
 		},
 		stdout: os.Stdout,
+		stderr: os.Stderr,
+		now:    time.Now,
 	}
 	cmd := &cobra.Command{
 		Use:   "breaking-changes",
@@ -37,16 +46,39 @@ func newBreakingChangesCmd(rootOptions *rootOptions) *cobra.Command {
 			return o.run()
 		},
 	}
+	cmd.Flags().StringVar(&o.exemptionsFile, "exemptions-file", "", "YAML file of reviewed exemptions; matching breaking changes are acknowledged instead of blocking")
+	cmd.Flags().IntVar(&o.majorVersion, "major-version", 0, "Major version the changes will be released in, used to find stale exemptions")
 	return cmd
 }
 func (o *breakingChangesOptions) run() error {
 	schemaDiff := o.computeSchemaDiff()
-	breakingChanges := breaking_changes.ComputeBreakingChanges(schemaDiff)
+	breakingChanges := breaking_changes.ComputeProviderBreakingChanges(schemaDiff)
 	sort.Slice(breakingChanges, func(i, j int) bool {
 		return breakingChanges[i].Message < breakingChanges[j].Message
 	})
+	var stale []breaking_changes.Exemption
+	if o.exemptionsFile != "" {
+		exemptions, err := breaking_changes.ReadExemptions(o.exemptionsFile)
+		if err != nil {
+			return fmt.Errorf("error reading exemptions: %w", err)
+		}
+		var unmatched []breaking_changes.Exemption
+		breakingChanges, stale, unmatched = breaking_changes.ApplyExemptions(breakingChanges, exemptions, o.now(), o.majorVersion)
+		// Exemptions also cover the changes of other pull requests, so unmatched ones are
+		// only reported.
+		for _, exemption := range unmatched {
+			fmt.Fprintf(o.stderr, "%s doesn't match any breaking change\n", exemption)
+		}
+	}
 	if err := json.NewEncoder(o.stdout).Encode(breakingChanges); err != nil {
 		return fmt.Errorf("error encoding json: %w", err)
 	}
+	if len(stale) > 0 {
+		var msg strings.Builder
+		for _, exemption := range stale {
+			fmt.Fprintf(&msg, "\n%s", exemption)
+		}
+		return fmt.Errorf("stale exemptions in %s, remove them:%s", o.exemptionsFile, msg.String())
+	}
 	return nil
 }
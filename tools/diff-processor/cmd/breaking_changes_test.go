@@ -3,7 +3,10 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/breaking_changes"
 	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
@@ -80,8 +83,10 @@ func TestBreakingChangesCmd(t *testing.T) {
 
 			var buf bytes.Buffer
 			o := breakingChangesOptions{
-				computeSchemaDiff: func() diff.SchemaDiff {
-					return diff.ComputeSchemaDiff(tc.oldResourceMap, tc.newResourceMap)
+				computeSchemaDiff: func() diff.ProviderSchemaDiff {
+					return diff.ProviderSchemaDiff{
+						Resources: diff.ComputeSchemaDiff(tc.oldResourceMap, tc.newResourceMap),
+					}
 				},
 				stdout: &buf,
 			}
@@ -105,3 +110,111 @@ func TestBreakingChangesCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestBreakingChangesCmdExemptions(t *testing.T) {
+	oldResourceMap := map[string]*schema.Resource{
+		"google-x": {
+			Schema: map[string]*schema.Schema{
+				"field-a": {Description: "beep", Optional: true, Deprecated: "use field-b"},
+				"field-b": {Description: "beep", Optional: true},
+			},
+		},
+	}
+	newResourceMap := map[string]*schema.Resource{
+		"google-x": {
+			Schema: map[string]*schema.Schema{
+				"field-b": {Description: "beep", Optional: true},
+			},
+		},
+	}
+	cases := map[string]struct {
+		exemptions       string
+		wantAcknowledged int
+		wantStderr       string
+		wantErr          bool
+	}{
+		"matching exemption": {
+			exemptions: `
+exemptions:
+  - resource: 'google-x'
+    field: 'field-a'
+    rule: 'resource-schema-field-removal-or-rename'
+    reason: 'Removed in the major release'
+    approver: 'reviewer'
+    major_version: 7
+`,
+			wantAcknowledged: 1,
+		},
+		"unmatched exemption": {
+			exemptions: `
+exemptions:
+  - resource: 'google-x'
+    field: 'field-b'
+    rule: 'resource-schema-field-removal-or-rename'
+    reason: 'Removed in the major release'
+    approver: 'reviewer'
+    major_version: 7
+`,
+			wantStderr: "exemption for rule \"resource-schema-field-removal-or-rename\" on resource \"google-x\" field \"field-b\" doesn't match any breaking change\n",
+		},
+		"stale exemption": {
+			exemptions: `
+exemptions:
+  - resource: 'google-x'
+    field: 'field-a'
+    rule: 'resource-schema-field-removal-or-rename'
+    reason: 'Removed in the major release'
+    approver: 'reviewer'
+    expiry: '2020-01-01'
+`,
+			wantErr: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			exemptionsFile := filepath.Join(t.TempDir(), "exemptions.yaml")
+			if err := os.WriteFile(exemptionsFile, []byte(tc.exemptions), 0644); err != nil {
+				t.Fatal(err)
+			}
+			var buf, stderr bytes.Buffer
+			o := breakingChangesOptions{
+				computeSchemaDiff: func() diff.ProviderSchemaDiff {
+					return diff.ProviderSchemaDiff{
+						Resources: diff.ComputeSchemaDiff(oldResourceMap, newResourceMap),
+					}
+				},
+				stdout:         &buf,
+				stderr:         &stderr,
+				now:            func() time.Time { return time.Date(2026, 3, 18, 0, 0, 0, 0, time.UTC) },
+				exemptionsFile: exemptionsFile,
+				majorVersion:   7,
+			}
+
+			err := o.run()
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("Unexpected error running command. Want error %v, got %v", tc.wantErr, err)
+			}
+			if stderr.String() != tc.wantStderr {
+				t.Errorf("Unexpected stderr. Want %q, got %q", tc.wantStderr, stderr.String())
+			}
+
+			var got []breaking_changes.BreakingChange
+			if err = json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("Failed to unmarshall output: %s", err)
+			}
+			acknowledged := 0
+			for _, breakingChange := range got {
+				if breakingChange.Severity == breaking_changes.SeverityAcknowledged {
+					acknowledged++
+				}
+			}
+			if acknowledged != tc.wantAcknowledged {
+				t.Errorf("Unexpected number of acknowledged changes. Want %d, got %d. Output: %s", tc.wantAcknowledged, acknowledged, buf.String())
+			}
+		})
+	}
+}
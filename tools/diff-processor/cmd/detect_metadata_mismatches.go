@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/detector"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+)
+
+const detectMetadataMismatchesDesc = `Compute list of resources whose metadata files are missing or don't match their schema`
+
+type detectMetadataMismatchesOptions struct {
+	rootOptions           *rootOptions
+	computeProviderSchema func() diff.ProviderSchema
+	stdout                io.Writer
+}
+
+func newDetectMetadataMismatchesCmd(rootOptions *rootOptions) *cobra.Command {
+	o := &detectMetadataMismatchesOptions{
+		rootOptions: rootOptions,
+		computeProviderSchema: func() diff.ProviderSchema {
+			return rootOptions.newProviderSchema()
+		},
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "detect-metadata-mismatches",
+		Short: detectMetadataMismatchesDesc,
+		Long:  detectMetadataMismatchesDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			return o.run()
+		},
+	}
+	return cmd
+}
+
+func (o *detectMetadataMismatchesOptions) run() error {
+	providerSchema := o.computeProviderSchema()
+	mismatches := detector.DetectMetadataMismatches(providerSchema.Resources, providerSchema.ResourceMetadata)
+
+	names := maps.Keys(mismatches)
+	slices.Sort(names)
+	sorted := []detector.MetadataMismatch{}
+	for _, name := range names {
+		sorted = append(sorted, mismatches[name])
+	}
+
+	if err := json.NewEncoder(o.stdout).Encode(sorted); err != nil {
+		return fmt.Errorf("error encoding json: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/detector"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDetectMetadataMismatchesCmd(t *testing.T) {
+	var buf bytes.Buffer
+	o := detectMetadataMismatchesOptions{
+		computeProviderSchema: func() diff.ProviderSchema {
+			return diff.ProviderSchema{
+				Resources: map[string]*schema.Resource{
+					"google-x": {
+						Schema: map[string]*schema.Schema{
+							"field-a": {Type: schema.TypeString},
+						},
+					},
+					"google-y": {
+						Schema: map[string]*schema.Schema{
+							"field-a": {Type: schema.TypeString},
+							"field-b": {Type: schema.TypeString},
+						},
+					},
+					"google-z": {
+						Schema: map[string]*schema.Schema{
+							"field-a": {Type: schema.TypeString},
+						},
+					},
+				},
+				ResourceMetadata: map[string]*metadata.Resource{
+					"google-x": {
+						Resource: "google-x",
+						Fields:   []metadata.Field{{Field: "field-a"}},
+						Path:     "resource_x_meta.yaml",
+					},
+					"google-y": {
+						Resource: "google-y",
+						Fields:   []metadata.Field{{Field: "field-a"}, {Field: "field-c"}},
+						Path:     "resource_y_meta.yaml",
+					},
+				},
+			}
+		},
+		stdout: &buf,
+	}
+
+	if err := o.run(); err != nil {
+		t.Fatalf("Error running command: %s", err)
+	}
+
+	var got []detector.MetadataMismatch
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Failed to unmarshall output: %s", err)
+	}
+	want := []detector.MetadataMismatch{
+		{
+			Name:          "google-y",
+			FilePath:      "resource_y_meta.yaml",
+			MissingFields: []string{"field-b"},
+			ExtraFields:   []string{"field-c"},
+		},
+		{Name: "google-z", MissingFile: true},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected output (-want, +got):\n%s", diff)
+	}
+}
@@ -12,9 +12,6 @@ import (
 	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
-
-	newProvider "google/provider/new/google/provider"
-	oldProvider "google/provider/old/google/provider"
 )
 
 const detectMissingDocDesc = `Compute list of fields missing documents`
@@ -41,10 +38,10 @@ func newDetectMissingDocsCmd(rootOptions *rootOptions) *cobra.Command {
 	o := &detectMissingDocsOptions{
 		rootOptions: rootOptions,
 		computeSchemaDiff: func() diff.SchemaDiff {
-			return schemaDiff
+			return rootOptions.providerSchemaDiff().Resources
 		},
 		computeDatasourceSchemaDiff: func() diff.SchemaDiff {
-			return diff.ComputeSchemaDiff(oldProvider.DatasourceMap(), newProvider.DatasourceMap())
+			return rootOptions.providerSchemaDiff().DataSources
 		},
 		stdout: os.Stdout,
 	}
@@ -42,7 +42,7 @@ func (o *detectMissingTestsOptions) run(args []string) error {
 		glog.Infof("error reading path: %s, err: %v", path, err)
 	}
 
-	missingTests, err := detector.DetectMissingTests(schemaDiff, allTests)
+	missingTests, err := detector.DetectMissingTests(o.rootOptions.providerSchemaDiff().Resources, allTests)
 	if err != nil {
 		return fmt.Errorf("error detecting missing tests: %v", err)
 	}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/snapshot"
+	"github.com/spf13/cobra"
+)
+
+const exportSchemaDesc = `Write a snapshot of the schema of the new (or old) provider, which can be diffed later with --old-schema and --new-schema`
+
+type exportSchemaOptions struct {
+	rootOptions           *rootOptions
+	computeProviderSchema func() diff.ProviderSchema
+	stdout                io.Writer
+
+	old bool
+}
+
+func newExportSchemaCmd(rootOptions *rootOptions) *cobra.Command {
+	o := &exportSchemaOptions{
+		rootOptions: rootOptions,
+		stdout:      os.Stdout,
+	}
+	o.computeProviderSchema = func() diff.ProviderSchema {
+		if o.old {
+			return rootOptions.oldProviderSchema()
+		}
+		return rootOptions.newProviderSchema()
+	}
+	cmd := &cobra.Command{
+		Use:   "export-schema",
+		Short: exportSchemaDesc,
+		Long:  exportSchemaDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			return o.run()
+		},
+	}
+	cmd.Flags().BoolVar(&o.old, "old", false, "Export the schema of the old provider instead of the new one")
+	return cmd
+}
+
+func (o *exportSchemaOptions) run() error {
+	if err := snapshot.Write(o.stdout, o.computeProviderSchema()); err != nil {
+		return fmt.Errorf("error writing schema snapshot: %w", err)
+	}
+	return nil
+}
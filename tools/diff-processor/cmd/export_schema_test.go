@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/snapshot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExportSchemaCmdRun(t *testing.T) {
+	providerSchema := diff.ProviderSchema{
+		Provider: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"project": {Type: schema.TypeString, Optional: true},
+			},
+		},
+		Resources: map[string]*schema.Resource{
+			"google_x_resource": {
+				Schema: map[string]*schema.Schema{
+					"field_a": {Type: schema.TypeString, Required: true, ForceNew: true},
+					"field_b": {Type: schema.TypeInt, Optional: true, Default: 3},
+				},
+			},
+		},
+		DataSources: map[string]*schema.Resource{
+			"google_x_resource": {
+				Schema: map[string]*schema.Schema{
+					"field_a": {Type: schema.TypeString, Required: true},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	o := exportSchemaOptions{
+		computeProviderSchema: func() diff.ProviderSchema {
+			return providerSchema
+		},
+		stdout: &buf,
+	}
+	if err := o.run(); err != nil {
+		t.Fatalf("Error running command: %s", err)
+	}
+
+	got, err := snapshot.Parse(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Unable to parse snapshot (%q): %s", buf.String(), err)
+	}
+	schemaDiff := diff.ComputeProviderSchemaDiff(providerSchema, got)
+	if len(schemaDiff.Provider.Fields) > 0 || len(schemaDiff.Resources) > 0 || len(schemaDiff.DataSources) > 0 {
+		t.Errorf("Exported schema differs from the provider schema: %+v", schemaDiff)
+	}
+}
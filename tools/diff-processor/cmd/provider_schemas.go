@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/snapshot"
+	"github.com/golang/glog"
+)
+
+// errNoCompiledProviders is returned when reading the schema of a provider
+// compiled into diff-processor, if it was built without providers.
+var errNoCompiledProviders = errors.New("diff-processor was built without providers, use --old-schema and --new-schema to read schema snapshots")
+
+// Read the schemas of the old and new providers compiled into diff-processor.
+// Only the providers module compiles them in, setting these with
+// SetCompiledSchemaReaders, so diff-processor builds without the old / new
+// provider clones.
+var (
+	readOldCompiledSchema = readNoCompiledSchema
+	readNewCompiledSchema = readNoCompiledSchema
+)
+
+// SetCompiledSchemaReaders sets the functions reading the schemas of the old
+// and new providers compiled into diff-processor, which are read unless
+// --old-schema and --new-schema are given. It must be called before Execute.
+func SetCompiledSchemaReaders(readOld, readNew func() (diff.ProviderSchema, error)) {
+	readOldCompiledSchema = readOld
+	readNewCompiledSchema = readNew
+}
+
+func readNoCompiledSchema() (diff.ProviderSchema, error) {
+	return diff.ProviderSchema{}, errNoCompiledProviders
+}
+
+// oldProviderSchema returns the schema of the old provider, read from the snapshot
+// given with --old-schema or else from the old provider compiled into diff-processor.
+// Schemas are only read when a command first needs them.
+func (o *rootOptions) oldProviderSchema() diff.ProviderSchema {
+	if o.oldSchema == nil {
+		providerSchema, err := readProviderSchema(o.oldSchemaFile, readOldCompiledSchema)
+		if err != nil {
+			glog.Exitf("error reading old provider schema: %v", err)
+		}
+		o.oldSchema = &providerSchema
+	}
+	return *o.oldSchema
+}
+
+// newProviderSchema is oldProviderSchema for the new provider and --new-schema.
+func (o *rootOptions) newProviderSchema() diff.ProviderSchema {
+	if o.newSchema == nil {
+		providerSchema, err := readProviderSchema(o.newSchemaFile, readNewCompiledSchema)
+		if err != nil {
+			glog.Exitf("error reading new provider schema: %v", err)
+		}
+		o.newSchema = &providerSchema
+	}
+	return *o.newSchema
+}
+
+// providerSchemaDiff returns the diff between the old and new provider schemas.
+func (o *rootOptions) providerSchemaDiff() diff.ProviderSchemaDiff {
+	if o.schemaDiff == nil {
+		oldSchema, newSchema := o.oldProviderSchema(), o.newProviderSchema()
+		if err := checkSchemaSources(oldSchema, newSchema); err != nil {
+			glog.Exit(err)
+		}
+		schemaDiff := diff.ComputeProviderSchemaDiff(oldSchema, newSchema)
+		o.schemaDiff = &schemaDiff
+	}
+	return *o.schemaDiff
+}
+
+// checkSchemaSources returns an error if the old and new schemas were read from
+// different formats. `terraform providers schema -json` lacks details the other
+// side has, such as ForceNew and int types, so their diff would report breaking
+// changes across the provider.
+func checkSchemaSources(oldSchema, newSchema diff.ProviderSchema) error {
+	if oldSchema.Source == newSchema.Source {
+		return nil
+	}
+	return fmt.Errorf("can't diff the old schema from %s against the new schema from %s, read both from the same format", schemaSourceName(oldSchema.Source), schemaSourceName(newSchema.Source))
+}
+
+func schemaSourceName(source diff.SchemaSource) string {
+	if source == diff.SchemaSourceTerraform {
+		return "`terraform providers schema -json`"
+	}
+	return "the provider (or export-schema)"
+}
+
+func readProviderSchema(snapshotFile string, readCompiledSchema func() (diff.ProviderSchema, error)) (diff.ProviderSchema, error) {
+	if snapshotFile != "" {
+		return snapshot.Read(snapshotFile)
+	}
+	return readCompiledSchema()
+}
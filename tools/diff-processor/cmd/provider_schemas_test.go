@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+)
+
+func TestCheckSchemaSources(t *testing.T) {
+	cases := map[string]struct {
+		oldSource diff.SchemaSource
+		newSource diff.SchemaSource
+		wantErr   bool
+	}{
+		"provider schemas": {
+			oldSource: diff.SchemaSourceProvider,
+			newSource: diff.SchemaSourceProvider,
+		},
+		"terraform schemas": {
+			oldSource: diff.SchemaSourceTerraform,
+			newSource: diff.SchemaSourceTerraform,
+		},
+		"old terraform schema": {
+			oldSource: diff.SchemaSourceTerraform,
+			newSource: diff.SchemaSourceProvider,
+			wantErr:   true,
+		},
+		"new terraform schema": {
+			oldSource: diff.SchemaSourceProvider,
+			newSource: diff.SchemaSourceTerraform,
+			wantErr:   true,
+		},
+	}
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			err := checkSchemaSources(diff.ProviderSchema{Source: tc.oldSource}, diff.ProviderSchema{Source: tc.newSource})
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("checkSchemaSources() error = %v, want error %v", err, tc.wantErr)
+			}
+		})
+	}
+}
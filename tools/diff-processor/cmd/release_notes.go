@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/breaking_changes"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/release_notes"
+	"github.com/spf13/cobra"
+)
+
+const releaseNotesDesc = `Draft the release notes (or the upgrade guide sections of a major release) for the changes between the new / old Terraform provider versions.`
+
+type releaseNotesOptions struct {
+	rootOptions       *rootOptions
+	computeSchemaDiff func() diff.ProviderSchemaDiff
+	stdout            io.Writer
+	now               func() time.Time
+
+	exemptionsFile string
+	upgradeGuide   bool
+}
+
+func newReleaseNotesCmd(rootOptions *rootOptions) *cobra.Command {
+	o := &releaseNotesOptions{
+		rootOptions: rootOptions,
+		computeSchemaDiff: func() diff.ProviderSchemaDiff {
+			return rootOptions.providerSchemaDiff()
+		},
+		stdout: os.Stdout,
+		now:    time.Now,
+	}
+	cmd := &cobra.Command{
+		Use:   "release-notes",
+		Short: releaseNotesDesc,
+		Long:  releaseNotesDesc,
+		RunE: func(c *cobra.Command, args []string) error {
+			return o.run()
+		},
+	}
+	cmd.Flags().StringVar(&o.exemptionsFile, "exemptions-file", "", "YAML file of reviewed exemptions; their reasons are used to describe the breaking changes they cover")
+	cmd.Flags().BoolVar(&o.upgradeGuide, "upgrade-guide", false, "Draft the upgrade guide sections of a major release instead of release notes")
+	return cmd
+}
+
+func (o *releaseNotesOptions) run() error {
+	schemaDiff := o.computeSchemaDiff()
+	breakingChanges := breaking_changes.ComputeProviderBreakingChanges(schemaDiff)
+	if o.exemptionsFile != "" {
+		exemptions, err := breaking_changes.ReadExemptions(o.exemptionsFile)
+		if err != nil {
+			return fmt.Errorf("error reading exemptions: %w", err)
+		}
+		// Stale and unmatched exemptions are reported by the breaking-changes command.
+		breakingChanges, _, _ = breaking_changes.ApplyExemptions(breakingChanges, exemptions, o.now(), 0)
+	}
+
+	if o.upgradeGuide {
+		if _, err := io.WriteString(o.stdout, release_notes.UpgradeGuide(breakingChanges)); err != nil {
+			return fmt.Errorf("error writing upgrade guide: %w", err)
+		}
+		return nil
+	}
+
+	entry := release_notes.NewEntry(release_notes.ComputeNotes(schemaDiff, breakingChanges))
+	if _, err := io.WriteString(o.stdout, entry.Body); err != nil {
+		return fmt.Errorf("error writing release notes: %w", err)
+	}
+	var errs []error
+	for _, err := range entry.Validate() {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid release notes drafted:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/google/go-cmp/cmp"
+	changelog "github.com/hashicorp/go-changelog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestReleaseNotesCmd(t *testing.T) {
+	oldResourceMap := map[string]*schema.Resource{
+		"google_pubsub_topic": {
+			Schema: map[string]*schema.Schema{
+				"name":                       {Required: true},
+				"message_retention_duration": {Optional: true, Deprecated: "use message_retention_period"},
+			},
+		},
+	}
+	newResourceMap := map[string]*schema.Resource{
+		"google_pubsub_topic": {
+			Schema: map[string]*schema.Schema{
+				"name":                     {Required: true},
+				"message_retention_period": {Optional: true},
+				"kms_key_name":             {Optional: true},
+			},
+		},
+		"google_pubsub_schema": {
+			Schema: map[string]*schema.Schema{
+				"name": {Required: true},
+			},
+		},
+	}
+	exemptions := `
+exemptions:
+  - resource: 'google_pubsub_topic'
+    field: 'message_retention_duration'
+    rule: 'resource-schema-field-removal-or-rename'
+    reason: 'Removed in favor of message_retention_period'
+    approver: 'reviewer'
+    major_version: 7
+`
+	cases := map[string]struct {
+		upgradeGuide bool
+		want         string
+	}{
+		"release notes": {
+			want: "```release-note:breaking-change\n" +
+				"pubsub: field `message_retention_duration` within resource `google_pubsub_topic` was either removed or renamed\n" +
+				"```\n\n" +
+				"```release-note:enhancement\n" +
+				"pubsub: added `kms_key_name` and `message_retention_period` fields to `google_pubsub_topic` resource\n" +
+				"```\n\n" +
+				"```release-note:new-resource\n" +
+				"`google_pubsub_schema`\n" +
+				"```\n",
+		},
+		"upgrade guide": {
+			upgradeGuide: true,
+			want: "## Resource: `google_pubsub_topic`\n\n" +
+				"### Field `message_retention_duration` within resource `google_pubsub_topic` was either removed or renamed\n\n" +
+				"Removed in favor of message_retention_period\n\n",
+		},
+	}
+
+	for tn, tc := range cases {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			exemptionsFile := filepath.Join(t.TempDir(), "exemptions.yaml")
+			if err := os.WriteFile(exemptionsFile, []byte(exemptions), 0644); err != nil {
+				t.Fatal(err)
+			}
+			var buf bytes.Buffer
+			o := releaseNotesOptions{
+				computeSchemaDiff: func() diff.ProviderSchemaDiff {
+					return diff.ProviderSchemaDiff{
+						Resources: diff.ComputeSchemaDiff(oldResourceMap, newResourceMap),
+					}
+				},
+				stdout:         &buf,
+				now:            func() time.Time { return time.Date(2026, 3, 18, 0, 0, 0, 0, time.UTC) },
+				exemptionsFile: exemptionsFile,
+				upgradeGuide:   tc.upgradeGuide,
+			}
+
+			if err := o.run(); err != nil {
+				t.Errorf("Error running command: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, buf.String()); diff != "" {
+				t.Errorf("Unexpected output (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReleaseNotesCmdNoChanges(t *testing.T) {
+	var buf bytes.Buffer
+	o := releaseNotesOptions{
+		computeSchemaDiff: func() diff.ProviderSchemaDiff {
+			return diff.ProviderSchemaDiff{}
+		},
+		stdout: &buf,
+	}
+	if err := o.run(); err != nil {
+		t.Errorf("Error running command: %s", err)
+	}
+	entry := changelog.Entry{Body: buf.String()}
+	if diff := cmp.Diff([]changelog.Note{{Type: "none"}}, changelog.NotesFromEntry(entry)); diff != "" {
+		t.Errorf("Unexpected release notes (-want, +got):\n%s", diff)
+	}
+}
@@ -4,12 +4,19 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
 	"github.com/spf13/cobra"
 )
 
 const rootCmdDesc = "Utilities for interacting with diffs between Terraform schema versions."
 
 type rootOptions struct {
+	oldSchemaFile string
+	newSchemaFile string
+
+	oldSchema  *diff.ProviderSchema
+	newSchema  *diff.ProviderSchema
+	schemaDiff *diff.ProviderSchemaDiff
 }
 
 func newRootCmd() (*cobra.Command, *rootOptions, error) {
@@ -21,10 +28,15 @@ func newRootCmd() (*cobra.Command, *rootOptions, error) {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 	}
+	cmd.PersistentFlags().StringVar(&o.oldSchemaFile, "old-schema", "", "Schema snapshot (from export-schema or `terraform providers schema -json`) to use instead of the compiled old provider")
+	cmd.PersistentFlags().StringVar(&o.newSchemaFile, "new-schema", "", "Schema snapshot (from export-schema or `terraform providers schema -json`) to use instead of the compiled new provider")
 	cmd.AddCommand(newBreakingChangesCmd(o))
 	cmd.AddCommand(newDetectMissingTestsCmd(o))
 	cmd.AddCommand(newSchemaDiffCmd(o))
 	cmd.AddCommand(newDetectMissingDocsCmd(o))
+	cmd.AddCommand(newDetectMetadataMismatchesCmd(o))
+	cmd.AddCommand(newReleaseNotesCmd(o))
+	cmd.AddCommand(newExportSchemaCmd(o))
 	return cmd, o, nil
 }
 
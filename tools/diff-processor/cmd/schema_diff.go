@@ -1,38 +1,48 @@
 package cmd
 
 import (
-	newProvider "google/provider/new/google/provider"
-	oldProvider "google/provider/old/google/provider"
-
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"sort"
 
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/breaking_changes"
 	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/report"
 	"github.com/spf13/cobra"
 )
 
-const schemaDiffDesc = `Return a simple summary of the schema diff for this build.`
+const schemaDiffDesc = `Return a simple summary of the schema diff for this build, or a detailed report of the changes of each field.`
 
-var schemaDiff = diff.ComputeSchemaDiff(oldProvider.ResourceMap(), newProvider.ResourceMap())
+// Formats of the schema-diff command output.
+const (
+	formatJSON     = "json"
+	formatMarkdown = "markdown"
+	formatSARIF    = "sarif"
+)
 
 type simpleSchemaDiff struct {
-	AddedResources, ModifiedResources, RemovedResources []string
+	AddedResources, ModifiedResources, RemovedResources                            []string
+	AddedDataSources, ModifiedDataSources, RemovedDataSources                      []string
+	AddedEphemeralResources, ModifiedEphemeralResources, RemovedEphemeralResources []string
+	ModifiedProviderFields                                                         []string
 }
 
 type schemaDiffOptions struct {
 	rootOptions       *rootOptions
-	computeSchemaDiff func() diff.SchemaDiff
+	computeSchemaDiff func() diff.ProviderSchemaDiff
 	stdout            io.Writer
+
+	format  string
+	mmv1Dir string
 }
 
 func newSchemaDiffCmd(rootOptions *rootOptions) *cobra.Command {
 	o := &schemaDiffOptions{
 		rootOptions: rootOptions,
-		computeSchemaDiff: func() diff.SchemaDiff {
-			return schemaDiff
+		computeSchemaDiff: func() diff.ProviderSchemaDiff {
+			return rootOptions.providerSchemaDiff()
 		},
 		stdout: os.Stdout,
 	}
@@ -45,26 +55,40 @@ func newSchemaDiffCmd(rootOptions *rootOptions) *cobra.Command {
 			return o.run()
 		},
 	}
+	cmd.Flags().StringVar(&o.format, "format", formatJSON, "Output format: json (summary of the changed resources), markdown or sarif (detailed report of the changed fields)")
+	cmd.Flags().StringVar(&o.mmv1Dir, "mmv1-dir", "", "mmv1 directory of the Magic Modules checkout, used to locate fields in their product YAML files, or the metadata files of handwritten resources, for sarif")
 	return cmd
 }
 func (o *schemaDiffOptions) run() error {
 	schemaDiff := o.computeSchemaDiff()
 
-	simple := simpleSchemaDiff{}
-
-	for k, d := range schemaDiff {
-		if d.ResourceConfig.Old == nil {
-			simple.AddedResources = append(simple.AddedResources, k)
-		} else if d.ResourceConfig.New == nil {
-			simple.RemovedResources = append(simple.RemovedResources, k)
-		} else {
-			simple.ModifiedResources = append(simple.ModifiedResources, k)
+	switch o.format {
+	case "", formatJSON:
+	case formatMarkdown:
+		r := report.ComputeReport(schemaDiff, breaking_changes.ComputeProviderBreakingChanges(schemaDiff))
+		if _, err := io.WriteString(o.stdout, report.RenderMarkdown(r)); err != nil {
+			return fmt.Errorf("Error writing markdown: %w", err)
 		}
+		return nil
+	case formatSARIF:
+		r := report.ComputeReport(schemaDiff, breaking_changes.ComputeProviderBreakingChanges(schemaDiff))
+		sarif := report.NewSARIF(r, report.NewLocator(o.mmv1Dir, schemaDiff.Resources))
+		if err := json.NewEncoder(o.stdout).Encode(sarif); err != nil {
+			return fmt.Errorf("Error encoding json: %w", err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format %q, expected one of %q, %q or %q", o.format, formatJSON, formatMarkdown, formatSARIF)
 	}
 
-	sort.Strings(simple.AddedResources)
-	sort.Strings(simple.ModifiedResources)
-	sort.Strings(simple.RemovedResources)
+	simple := simpleSchemaDiff{}
+	simple.AddedResources, simple.ModifiedResources, simple.RemovedResources = summarizeSchemaDiff(schemaDiff.Resources)
+	simple.AddedDataSources, simple.ModifiedDataSources, simple.RemovedDataSources = summarizeSchemaDiff(schemaDiff.DataSources)
+	simple.AddedEphemeralResources, simple.ModifiedEphemeralResources, simple.RemovedEphemeralResources = summarizeSchemaDiff(schemaDiff.EphemeralResources)
+	for field := range schemaDiff.Provider.Fields {
+		simple.ModifiedProviderFields = append(simple.ModifiedProviderFields, field)
+	}
+	sort.Strings(simple.ModifiedProviderFields)
 
 	if err := json.NewEncoder(o.stdout).Encode(simple); err != nil {
 		return fmt.Errorf("Error encoding json: %w", err)
@@ -72,3 +96,20 @@ func (o *schemaDiffOptions) run() error {
 
 	return nil
 }
+
+func summarizeSchemaDiff(schemaDiff diff.SchemaDiff) (added, modified, removed []string) {
+	for k, d := range schemaDiff {
+		if d.ResourceConfig.Old == nil {
+			added = append(added, k)
+		} else if d.ResourceConfig.New == nil {
+			removed = append(removed, k)
+		} else {
+			modified = append(modified, k)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(modified)
+	sort.Strings(removed)
+	return added, modified, removed
+}
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	_ "embed"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/report"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -256,8 +258,10 @@ func TestSchemaDiffCmdRun(t *testing.T) {
 
 			var buf bytes.Buffer
 			o := schemaDiffOptions{
-				computeSchemaDiff: func() diff.SchemaDiff {
-					return diff.ComputeSchemaDiff(tc.oldResourceMap, tc.newResourceMap)
+				computeSchemaDiff: func() diff.ProviderSchemaDiff {
+					return diff.ProviderSchemaDiff{
+						Resources: diff.ComputeSchemaDiff(tc.oldResourceMap, tc.newResourceMap),
+					}
 				},
 				stdout: &buf,
 			}
@@ -280,3 +284,91 @@ func TestSchemaDiffCmdRun(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaDiffCmdRunFormats(t *testing.T) {
+	computeSchemaDiff := func() diff.ProviderSchemaDiff {
+		return diff.ProviderSchemaDiff{
+			Resources: diff.ComputeSchemaDiff(
+				map[string]*schema.Resource{
+					"google_x_resource": {
+						Schema: map[string]*schema.Schema{
+							"field_a": {Type: schema.TypeString, Optional: true},
+						},
+					},
+				},
+				map[string]*schema.Resource{
+					"google_x_resource": {
+						Schema: map[string]*schema.Schema{
+							"field_a": {Type: schema.TypeString, Required: true},
+						},
+					},
+				},
+			),
+		}
+	}
+
+	t.Run("markdown", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		o := schemaDiffOptions{
+			computeSchemaDiff: computeSchemaDiff,
+			stdout:            &buf,
+			format:            formatMarkdown,
+		}
+		if err := o.run(); err != nil {
+			t.Fatalf("Error running command: %s", err)
+		}
+		for _, want := range []string{
+			"### Resource: `google_x_resource` (modified)",
+			"| `field_a` | modified | Required | - | true |",
+			"## Breaking changes",
+		} {
+			if !strings.Contains(buf.String(), want) {
+				t.Errorf("Markdown output %q doesn't contain %q", buf.String(), want)
+			}
+		}
+	})
+
+	t.Run("sarif", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		o := schemaDiffOptions{
+			computeSchemaDiff: computeSchemaDiff,
+			stdout:            &buf,
+			format:            formatSARIF,
+		}
+		if err := o.run(); err != nil {
+			t.Fatalf("Error running command: %s", err)
+		}
+		var got report.SARIF
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("Unable to unmarshal sarif (%q): %s", buf.String(), err)
+		}
+		if len(got.Runs) != 1 {
+			t.Fatalf("Unexpected number of runs. Want 1, got %d", len(got.Runs))
+		}
+		var levels []string
+		for _, result := range got.Runs[0].Results {
+			levels = append(levels, result.Level)
+		}
+		if diff := cmp.Diff([]string{"error", "note"}, levels); diff != "" {
+			t.Errorf("Unexpected result levels (-want, +got):\n%s", diff)
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		o := schemaDiffOptions{
+			computeSchemaDiff: computeSchemaDiff,
+			stdout:            &buf,
+			format:            "html",
+		}
+		if err := o.run(); err == nil {
+			t.Errorf("Expected an error for an unknown format")
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package detector
+
+import (
+	"sort"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// MetadataMismatch denotes the differences between the schema of a resource and the
+// fields listed in its metadata file. MissingFile is true if the resource has no
+// metadata file, in which case no fields are listed.
+type MetadataMismatch struct {
+	Name          string
+	FilePath      string
+	MissingFile   bool
+	MissingFields []string
+	ExtraFields   []string
+}
+
+// DetectMetadataMismatches cross-checks the flattened schema of each resource with its
+// metadata. It returns the resources with no metadata file, or with leaf fields that
+// are missing from (or only listed in) their metadata file, keyed by resource name.
+func DetectMetadataMismatches(resources map[string]*schema.Resource, resourceMetadata map[string]*metadata.Resource) map[string]MetadataMismatch {
+	mismatches := make(map[string]MetadataMismatch)
+	for name, resource := range resources {
+		m, ok := resourceMetadata[name]
+		if !ok {
+			mismatches[name] = MetadataMismatch{Name: name, MissingFile: true}
+			continue
+		}
+		schemaFields := make(map[string]struct{})
+		addLeafFields("", resource.Schema, schemaFields)
+		metadataFields := m.FieldsByName()
+
+		mismatch := MetadataMismatch{Name: name, FilePath: m.Path}
+		for field := range schemaFields {
+			if _, ok := metadataFields[field]; !ok {
+				mismatch.MissingFields = append(mismatch.MissingFields, field)
+			}
+		}
+		for field := range metadataFields {
+			if _, ok := schemaFields[field]; !ok {
+				mismatch.ExtraFields = append(mismatch.ExtraFields, field)
+			}
+		}
+		if len(mismatch.MissingFields) == 0 && len(mismatch.ExtraFields) == 0 {
+			continue
+		}
+		sort.Strings(mismatch.MissingFields)
+		sort.Strings(mismatch.ExtraFields)
+		mismatches[name] = mismatch
+	}
+	return mismatches
+}
+
+// addLeafFields adds the flattened names of the fields of s that have no nested fields,
+// which are the fields listed in metadata files.
+func addLeafFields(parentKey string, s map[string]*schema.Schema, fields map[string]struct{}) {
+	if parentKey != "" {
+		parentKey += "."
+	}
+	for fieldName, field := range s {
+		key := parentKey + fieldName
+		if child, ok := field.Elem.(*schema.Resource); ok && len(child.Schema) > 0 {
+			addLeafFields(key, child.Schema, fields)
+			continue
+		}
+		fields[key] = struct{}{}
+	}
+}
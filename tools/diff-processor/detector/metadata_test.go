@@ -0,0 +1,123 @@
+package detector
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDetectMetadataMismatches(t *testing.T) {
+	resourceMetadata, err := metadata.ReadDir("../testdata/google/services")
+	if err != nil {
+		t.Fatalf("error reading metadata: %v", err)
+	}
+	for _, test := range []struct {
+		name      string
+		resources map[string]*schema.Resource
+		want      map[string]MetadataMismatch
+	}{
+		{
+			name: "matching metadata",
+			resources: map[string]*schema.Resource{
+				"google_pubsub_lite_reservation": {
+					Schema: map[string]*schema.Schema{
+						"name":                {Type: schema.TypeString},
+						"throughput_capacity": {Type: schema.TypeInt},
+					},
+				},
+			},
+			want: map[string]MetadataMismatch{},
+		},
+		{
+			name: "generated metadata",
+			resources: map[string]*schema.Resource{
+				"google_pubsub_topic": {
+					Schema: map[string]*schema.Schema{
+						"name":             {Type: schema.TypeString},
+						"effective_labels": {Type: schema.TypeMap},
+						// Injected by the provider rather than declared as a property.
+						"project": {Type: schema.TypeString},
+						"message_storage_policy": {
+							Type: schema.TypeList,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_persistence_regions": {Type: schema.TypeSet},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: map[string]MetadataMismatch{},
+		},
+		{
+			name: "missing and extra fields",
+			resources: map[string]*schema.Resource{
+				"google_pubsub_topic": {
+					Schema: map[string]*schema.Schema{
+						"name":             {Type: schema.TypeString},
+						"effective_labels": {Type: schema.TypeMap},
+						"message_storage_policy": {
+							Type: schema.TypeList,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enforce_in_transit": {Type: schema.TypeBool},
+								},
+							},
+						},
+						"ingestion_data_source_settings": {
+							Type: schema.TypeList,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloud_storage": {
+										Type: schema.TypeList,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												// Empty blocks are leaf fields.
+												"avro_format": {Type: schema.TypeList, Elem: &schema.Resource{}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: map[string]MetadataMismatch{
+				"google_pubsub_topic": {
+					Name:     "google_pubsub_topic",
+					FilePath: "../testdata/google/services/pubsub/resource_pubsub_topic_generated_meta.yaml",
+					MissingFields: []string{
+						"ingestion_data_source_settings.cloud_storage.avro_format",
+						"message_storage_policy.enforce_in_transit",
+					},
+					ExtraFields: []string{
+						"message_storage_policy.allowed_persistence_regions",
+						"project",
+					},
+				},
+			},
+		},
+		{
+			name: "missing file",
+			resources: map[string]*schema.Resource{
+				"google_pubsub_schema": {
+					Schema: map[string]*schema.Schema{
+						"name": {Type: schema.TypeString},
+					},
+				},
+			},
+			want: map[string]MetadataMismatch{
+				"google_pubsub_schema": {Name: "google_pubsub_schema", MissingFile: true},
+			},
+		},
+	} {
+		got := DetectMetadataMismatches(test.resources, resourceMetadata)
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("test %s: DetectMetadataMismatches() unexpected diff (-want, +got):\n%s", test.name, diff)
+		}
+	}
+}
@@ -5,7 +5,11 @@ import (
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
 )
 
 // SchemaDiff is a nested map with resource names as top-level keys.
@@ -31,49 +35,164 @@ type ResourceFieldSets struct {
 
 type FieldSet map[string]struct{}
 
+// ResourceConfigDiff holds the resource-level configuration of the old and new
+// resource (without its fields), plus its metadata if any was loaded.
+// OldFromProtocol and NewFromProtocol are set if the old or new resource was converted
+// from the protocol schema, so that it lacks SDKv2 details like state upgraders.
 type ResourceConfigDiff struct {
-	Old *schema.Resource
-	New *schema.Resource
+	Old             *schema.Resource
+	New             *schema.Resource
+	OldMetadata     *metadata.Resource
+	NewMetadata     *metadata.Resource
+	OldFromProtocol bool
+	NewFromProtocol bool
 }
 
+// FieldDiff holds the old and new schema of a field, plus its metadata if any was loaded.
 type FieldDiff struct {
-	Old *schema.Schema
-	New *schema.Schema
+	Old         *schema.Schema
+	New         *schema.Schema
+	OldMetadata *metadata.Field
+	NewMetadata *metadata.Field
+}
+
+// FunctionDiff holds the old and new signatures of a provider function.
+type FunctionDiff struct {
+	Old *tfprotov5.Function
+	New *tfprotov5.Function
+}
+
+// ProviderSchemaDiff is the diff between the full schemas of two provider versions.
+// Provider holds the diff of the provider configuration; it has no fields if the
+// provider configuration is unchanged.
+type ProviderSchemaDiff struct {
+	Provider           ResourceDiff
+	Resources          SchemaDiff
+	DataSources        SchemaDiff
+	EphemeralResources SchemaDiff
+	Functions          map[string]FunctionDiff
+}
+
+func ComputeProviderSchemaDiff(oldSchema, newSchema ProviderSchema) ProviderSchemaDiff {
+	providerDiff, _ := computeResourceDiff(oldSchema.Provider, newSchema.Provider, nil, nil)
+	return ProviderSchemaDiff{
+		Provider:           providerDiff,
+		Resources:          computeResourcesDiff(oldSchema, newSchema),
+		DataSources:        ComputeSchemaDiff(oldSchema.DataSources, newSchema.DataSources),
+		EphemeralResources: ComputeSchemaDiff(oldSchema.EphemeralResources, newSchema.EphemeralResources),
+		Functions:          computeFunctionsDiff(oldSchema.Functions, newSchema.Functions),
+	}
 }
 
 func ComputeSchemaDiff(oldResourceMap, newResourceMap map[string]*schema.Resource) SchemaDiff {
+	return computeSchemaDiff(oldResourceMap, newResourceMap, nil, nil)
+}
+
+// computeResourcesDiff diffs the resources of two provider schemas, recording which
+// of them were converted from the protocol schema.
+func computeResourcesDiff(oldSchema, newSchema ProviderSchema) SchemaDiff {
+	schemaDiff := computeSchemaDiff(oldSchema.Resources, newSchema.Resources, oldSchema.ResourceMetadata, newSchema.ResourceMetadata)
+	for resource, resourceDiff := range schemaDiff {
+		resourceDiff.ResourceConfig.OldFromProtocol = oldSchema.ProtocolResources[resource]
+		resourceDiff.ResourceConfig.NewFromProtocol = newSchema.ProtocolResources[resource]
+		schemaDiff[resource] = resourceDiff
+	}
+	return schemaDiff
+}
+
+func computeSchemaDiff(oldResourceMap, newResourceMap map[string]*schema.Resource, oldMetadata, newMetadata map[string]*metadata.Resource) SchemaDiff {
 	schemaDiff := make(SchemaDiff)
 	for resource := range union(oldResourceMap, newResourceMap) {
-		// Compute diff between old and new resources and fields.
-		// TODO: add support for computing diff between resource configs, not just whether the
-		// resource was added/removed. b/300114839
-		resourceDiff := ResourceDiff{}
-		var flattenedOldSchema map[string]*schema.Schema
-		if oldResource, ok := oldResourceMap[resource]; ok {
-			flattenedOldSchema = flattenSchema("", oldResource.Schema)
-			resourceDiff.ResourceConfig.Old = &schema.Resource{}
-		}
-
-		var flattenedNewSchema map[string]*schema.Schema
-		if newResource, ok := newResourceMap[resource]; ok {
-			flattenedNewSchema = flattenSchema("", newResource.Schema)
-			resourceDiff.ResourceConfig.New = &schema.Resource{}
+		if resourceDiff, changed := computeResourceDiff(oldResourceMap[resource], newResourceMap[resource], oldMetadata[resource], newMetadata[resource]); changed {
+			schemaDiff[resource] = resourceDiff
 		}
+	}
+	return schemaDiff
+}
 
-		resourceDiff.Fields = make(map[string]FieldDiff)
-		for key := range union(flattenedOldSchema, flattenedNewSchema) {
-			oldField := flattenedOldSchema[key]
-			newField := flattenedNewSchema[key]
-			if fieldDiff, fieldSetsDiff, changed := diffFields(oldField, newField, key); changed {
-				resourceDiff.Fields[key] = fieldDiff
-				resourceDiff.FieldSets = mergeFieldSetsDiff(resourceDiff.FieldSets, fieldSetsDiff)
-			}
+func computeResourceDiff(oldResource, newResource *schema.Resource, oldMetadata, newMetadata *metadata.Resource) (ResourceDiff, bool) {
+	// Compute diff between old and new resources and fields.
+	resourceDiff := ResourceDiff{}
+	var flattenedOldSchema map[string]*schema.Schema
+	if oldResource != nil {
+		flattenedOldSchema = flattenSchema("", oldResource.Schema)
+		resourceDiff.ResourceConfig.Old = resourceConfig(oldResource)
+		resourceDiff.ResourceConfig.OldMetadata = oldMetadata
+	}
+
+	var flattenedNewSchema map[string]*schema.Schema
+	if newResource != nil {
+		flattenedNewSchema = flattenSchema("", newResource.Schema)
+		resourceDiff.ResourceConfig.New = resourceConfig(newResource)
+		resourceDiff.ResourceConfig.NewMetadata = newMetadata
+	}
+
+	oldMetadataFields := resourceDiff.ResourceConfig.OldMetadata.FieldsByName()
+	newMetadataFields := resourceDiff.ResourceConfig.NewMetadata.FieldsByName()
+	resourceDiff.Fields = make(map[string]FieldDiff)
+	for key := range union(flattenedOldSchema, flattenedNewSchema) {
+		oldField := flattenedOldSchema[key]
+		newField := flattenedNewSchema[key]
+		fieldDiff, fieldSetsDiff, changed := diffFields(oldField, newField, key)
+		// Validation is opaque in the schema, so it's compared through the metadata.
+		if !changed && fieldConstraintsChanged(oldMetadataFields[key], newMetadataFields[key]) {
+			fieldDiff = FieldDiff{Old: oldField, New: newField}
+			fieldSetsDiff = ResourceFieldSetsDiff{Old: fieldSets(oldField, key), New: fieldSets(newField, key)}
+			changed = true
 		}
-		if len(resourceDiff.Fields) > 0 || !cmp.Equal(resourceDiff.ResourceConfig.Old, resourceDiff.ResourceConfig.New) {
-			schemaDiff[resource] = resourceDiff
+		if changed {
+			fieldDiff.OldMetadata = oldMetadataFields[key]
+			fieldDiff.NewMetadata = newMetadataFields[key]
+			resourceDiff.Fields[key] = fieldDiff
+			resourceDiff.FieldSets = mergeFieldSetsDiff(resourceDiff.FieldSets, fieldSetsDiff)
 		}
 	}
-	return schemaDiff
+	changed := len(resourceDiff.Fields) > 0 ||
+		!cmp.Equal(resourceDiff.ResourceConfig.Old, resourceDiff.ResourceConfig.New, resourceConfigCmpOpts) ||
+		metadataChanged(resourceDiff.ResourceConfig.OldMetadata, resourceDiff.ResourceConfig.NewMetadata)
+	return resourceDiff, changed
+}
+
+// resourceConfigCmpOpts ignores the StateUpgrader fields that resourceConfig doesn't copy.
+var resourceConfigCmpOpts = cmpopts.IgnoreFields(schema.StateUpgrader{}, "Type", "Upgrade")
+
+// resourceConfig returns a copy of the resource-level configuration of r that can be
+// compared with cmp: its schema version, the versions covered by its state upgraders,
+// whether it's importable, its deprecation message and its default timeouts.
+func resourceConfig(r *schema.Resource) *schema.Resource {
+	config := &schema.Resource{
+		SchemaVersion:      r.SchemaVersion,
+		DeprecationMessage: r.DeprecationMessage,
+	}
+	for _, upgrader := range r.StateUpgraders {
+		config.StateUpgraders = append(config.StateUpgraders, schema.StateUpgrader{Version: upgrader.Version})
+	}
+	if r.Importer != nil {
+		config.Importer = &schema.ResourceImporter{}
+	}
+	if r.Timeouts != nil {
+		timeouts := *r.Timeouts
+		config.Timeouts = &timeouts
+	}
+	return config
+}
+
+// fieldConstraintsChanged returns whether the constraints recorded in the metadata
+// of a field changed. Missing metadata is never considered a change.
+func fieldConstraintsChanged(oldMetadata, newMetadata *metadata.Field) bool {
+	if oldMetadata == nil || newMetadata == nil {
+		return false
+	}
+	return !cmp.Equal(oldMetadata.Constraints, newMetadata.Constraints)
+}
+
+// metadataChanged returns whether the resource-level metadata relevant to breaking
+// changes (ID and import formats) changed. Missing metadata is never considered a change.
+func metadataChanged(oldMetadata, newMetadata *metadata.Resource) bool {
+	if oldMetadata == nil || newMetadata == nil {
+		return false
+	}
+	return oldMetadata.IdFormat != newMetadata.IdFormat || !cmp.Equal(oldMetadata.ImportFormats, newMetadata.ImportFormats)
 }
 
 func flattenSchema(parentKey string, schemaObj map[string]*schema.Schema) map[string]*schema.Schema {
@@ -1,15 +1,9 @@
 package diff
 
 import (
+	"fmt"
 	"testing"
 
-	newProvider "google/provider/new/google/provider"
-	newTpgresource "google/provider/new/google/tpgresource"
-	oldTpgresource "google/provider/new/google/tpgresource"
-	newVerify "google/provider/new/google/verify"
-	oldProvider "google/provider/old/google/provider"
-	oldVerify "google/provider/old/google/verify"
-
 	"github.com/davecgh/go-spew/spew"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
@@ -18,40 +12,6 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestNewProviderOldProviderChanges(t *testing.T) {
-	changes := ComputeSchemaDiff(oldProvider.ResourceMap(), newProvider.ResourceMap())
-
-	for resource, resourceDiff := range changes {
-		if resourceDiff.ResourceConfig.Old == nil {
-			t.Logf("%s is added", resource)
-			continue
-		}
-		if resourceDiff.ResourceConfig.New == nil {
-			t.Logf("%s is removed", resource)
-			continue
-		}
-		t.Logf("%s is modified", resource)
-		if diff := cmp.Diff(resourceDiff.ResourceConfig.Old, resourceDiff.ResourceConfig.New); diff != "" {
-			t.Logf("%s config changes (-old, +new):\n%s", resource, diff)
-		}
-		for field, fieldDiff := range resourceDiff.Fields {
-			if fieldDiff.Old == nil {
-				t.Logf("%s.%s is added", resource, field)
-				continue
-			}
-			if fieldDiff.New == nil {
-				t.Logf("%s.%s is removed", resource, field)
-				continue
-			}
-			t.Logf("%s.%s is modified", resource, field)
-			if diff := cmp.Diff(fieldDiff.Old, fieldDiff.New); diff != "" {
-				t.Logf("%s.%s changes (-old, +new):\n%s", resource, field, diff)
-			}
-		}
-
-	}
-}
-
 func TestFlattenSchema(t *testing.T) {
 	cases := map[string]struct {
 		resourceSchema  map[string]*schema.Schema
@@ -217,6 +177,24 @@ func testValidateDiagFunc1(v interface{}, p cty.Path) diag.Diagnostics {
 func testValidateDiagFunc2(v interface{}, p cty.Path) diag.Diagnostics {
 	return diag.Diagnostics{}
 }
+func testDiffSuppressFunc1(k, old, new string, d *schema.ResourceData) bool {
+	return false
+}
+func testDiffSuppressFunc2(k, old, new string, d *schema.ResourceData) bool {
+	return true
+}
+func testSetFunc1(v interface{}) int {
+	return 1
+}
+func testSetFunc2(v interface{}) int {
+	return 2
+}
+func testValidateFunc1(v interface{}, k string) ([]string, []error) {
+	return nil, nil
+}
+func testValidateFunc2(v interface{}, k string) ([]string, []error) {
+	return nil, []error{fmt.Errorf("invalid %s", k)}
+}
 
 func TestFieldChanged(t *testing.T) {
 	cases := map[string]struct {
@@ -580,23 +558,23 @@ func TestFieldChanged(t *testing.T) {
 		"DiffSuppressFunc added": {
 			oldField: &schema.Schema{},
 			newField: &schema.Schema{
-				DiffSuppressFunc: newTpgresource.CaseDiffSuppress,
+				DiffSuppressFunc: testDiffSuppressFunc1,
 			},
 			expectChanged: true,
 		},
 		"DiffSuppressFunc removed": {
 			oldField: &schema.Schema{
-				DiffSuppressFunc: oldTpgresource.CaseDiffSuppress,
+				DiffSuppressFunc: testDiffSuppressFunc2,
 			},
 			newField:      &schema.Schema{},
 			expectChanged: true,
 		},
 		"DiffSuppressFunc remains set": {
 			oldField: &schema.Schema{
-				DiffSuppressFunc: oldTpgresource.CaseDiffSuppress,
+				DiffSuppressFunc: testDiffSuppressFunc2,
 			},
 			newField: &schema.Schema{
-				DiffSuppressFunc: newTpgresource.CaseDiffSuppress,
+				DiffSuppressFunc: testDiffSuppressFunc1,
 			},
 			expectChanged: false,
 		},
@@ -609,7 +587,7 @@ func TestFieldChanged(t *testing.T) {
 			newField: &schema.Schema{
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
-					DiffSuppressFunc: oldTpgresource.CaseDiffSuppress,
+					DiffSuppressFunc: testDiffSuppressFunc2,
 				},
 			},
 			expectChanged: true,
@@ -618,7 +596,7 @@ func TestFieldChanged(t *testing.T) {
 			oldField: &schema.Schema{
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
-					DiffSuppressFunc: newTpgresource.CaseDiffSuppress,
+					DiffSuppressFunc: testDiffSuppressFunc1,
 				},
 			},
 			newField: &schema.Schema{
@@ -632,13 +610,13 @@ func TestFieldChanged(t *testing.T) {
 			oldField: &schema.Schema{
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
-					DiffSuppressFunc: newTpgresource.CaseDiffSuppress,
+					DiffSuppressFunc: testDiffSuppressFunc1,
 				},
 			},
 			newField: &schema.Schema{
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
-					DiffSuppressFunc: oldTpgresource.CaseDiffSuppress,
+					DiffSuppressFunc: testDiffSuppressFunc2,
 				},
 			},
 			expectChanged: false,
@@ -781,23 +759,23 @@ func TestFieldChanged(t *testing.T) {
 		"Set added": {
 			oldField: &schema.Schema{},
 			newField: &schema.Schema{
-				Set: newTpgresource.SelfLinkRelativePathHash,
+				Set: testSetFunc1,
 			},
 			expectChanged: true,
 		},
 		"Set removed": {
 			oldField: &schema.Schema{
-				Set: oldTpgresource.SelfLinkRelativePathHash,
+				Set: testSetFunc2,
 			},
 			newField:      &schema.Schema{},
 			expectChanged: true,
 		},
 		"Set remains set": {
 			oldField: &schema.Schema{
-				Set: oldTpgresource.SelfLinkRelativePathHash,
+				Set: testSetFunc2,
 			},
 			newField: &schema.Schema{
-				Set: newTpgresource.SelfLinkRelativePathHash,
+				Set: testSetFunc1,
 			},
 			expectChanged: false,
 		},
@@ -810,7 +788,7 @@ func TestFieldChanged(t *testing.T) {
 			newField: &schema.Schema{
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
-					Set:  newTpgresource.SelfLinkRelativePathHash,
+					Set:  testSetFunc1,
 				},
 			},
 			expectChanged: true,
@@ -819,7 +797,7 @@ func TestFieldChanged(t *testing.T) {
 			oldField: &schema.Schema{
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
-					Set:  oldTpgresource.SelfLinkRelativePathHash,
+					Set:  testSetFunc2,
 				},
 			},
 			newField: &schema.Schema{
@@ -833,13 +811,13 @@ func TestFieldChanged(t *testing.T) {
 			oldField: &schema.Schema{
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
-					Set:  oldTpgresource.SelfLinkRelativePathHash,
+					Set:  testSetFunc2,
 				},
 			},
 			newField: &schema.Schema{
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
-					Set:  newTpgresource.SelfLinkRelativePathHash,
+					Set:  testSetFunc1,
 				},
 			},
 			expectChanged: false,
@@ -848,23 +826,23 @@ func TestFieldChanged(t *testing.T) {
 		"ValidateFunc added": {
 			oldField: &schema.Schema{},
 			newField: &schema.Schema{
-				ValidateFunc: newVerify.ValidateBase64String,
+				ValidateFunc: testValidateFunc1,
 			},
 			expectChanged: true,
 		},
 		"ValidateFunc removed": {
 			oldField: &schema.Schema{
-				ValidateFunc: oldVerify.ValidateBase64String,
+				ValidateFunc: testValidateFunc2,
 			},
 			newField:      &schema.Schema{},
 			expectChanged: true,
 		},
 		"ValidateFunc remains set": {
 			oldField: &schema.Schema{
-				ValidateFunc: oldVerify.ValidateBase64String,
+				ValidateFunc: testValidateFunc2,
 			},
 			newField: &schema.Schema{
-				ValidateFunc: newVerify.ValidateBase64String,
+				ValidateFunc: testValidateFunc1,
 			},
 			expectChanged: false,
 		},
@@ -877,7 +855,7 @@ func TestFieldChanged(t *testing.T) {
 			newField: &schema.Schema{
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: newVerify.ValidateBase64String,
+					ValidateFunc: testValidateFunc1,
 				},
 			},
 			expectChanged: true,
@@ -886,7 +864,7 @@ func TestFieldChanged(t *testing.T) {
 			oldField: &schema.Schema{
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: oldVerify.ValidateBase64String,
+					ValidateFunc: testValidateFunc2,
 				},
 			},
 			newField: &schema.Schema{
@@ -900,13 +878,13 @@ func TestFieldChanged(t *testing.T) {
 			oldField: &schema.Schema{
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: oldVerify.ValidateBase64String,
+					ValidateFunc: testValidateFunc2,
 				},
 			},
 			newField: &schema.Schema{
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: newVerify.ValidateBase64String,
+					ValidateFunc: testValidateFunc1,
 				},
 			},
 			expectChanged: false,
@@ -1365,6 +1343,39 @@ func TestComputeSchemaDiff(t *testing.T) {
 				},
 			},
 		},
+		"resource-config-changed": {
+			oldResourceMap: map[string]*schema.Resource{
+				"google_service_one_resource_one": {
+					Schema: map[string]*schema.Schema{
+						"field_one": {
+							Type: schema.TypeString,
+						},
+					},
+					Importer: &schema.ResourceImporter{
+						StateContext: schema.ImportStatePassthroughContext,
+					},
+				},
+			},
+			newResourceMap: map[string]*schema.Resource{
+				"google_service_one_resource_one": {
+					Schema: map[string]*schema.Schema{
+						"field_one": {
+							Type: schema.TypeString,
+						},
+					},
+					SchemaVersion: 1,
+				},
+			},
+			expectedSchemaDiff: SchemaDiff{
+				"google_service_one_resource_one": ResourceDiff{
+					ResourceConfig: ResourceConfigDiff{
+						Old: &schema.Resource{Importer: &schema.ResourceImporter{}},
+						New: &schema.Resource{SchemaVersion: 1},
+					},
+					Fields: map[string]FieldDiff{},
+				},
+			},
+		},
 		"new-resource": {
 			newResourceMap: map[string]*schema.Resource{
 				"google_service_one_resource_one": {
@@ -1396,7 +1407,7 @@ func TestComputeSchemaDiff(t *testing.T) {
 		t.Run(tn, func(t *testing.T) {
 			t.Parallel()
 			schemaDiff := ComputeSchemaDiff(tc.oldResourceMap, tc.newResourceMap)
-			if diff := cmp.Diff(tc.expectedSchemaDiff, schemaDiff); diff != "" {
+			if diff := cmp.Diff(tc.expectedSchemaDiff, schemaDiff, resourceConfigCmpOpts); diff != "" {
 				t.Errorf("schema diff not equal (-want, +got):\n%s", diff)
 			}
 		})
@@ -0,0 +1,81 @@
+package diff
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func computeFunctionsDiff(oldFunctions, newFunctions map[string]*tfprotov5.Function) map[string]FunctionDiff {
+	functionsDiff := make(map[string]FunctionDiff)
+	for name := range union(oldFunctions, newFunctions) {
+		oldFunction := oldFunctions[name]
+		newFunction := newFunctions[name]
+		if functionChanged(oldFunction, newFunction) {
+			functionsDiff[name] = FunctionDiff{
+				Old: oldFunction,
+				New: newFunction,
+			}
+		}
+	}
+	return functionsDiff
+}
+
+func functionChanged(oldFunction, newFunction *tfprotov5.Function) bool {
+	if oldFunction == nil || newFunction == nil {
+		return oldFunction != newFunction
+	}
+	if oldFunction.Summary != newFunction.Summary {
+		return true
+	}
+	if oldFunction.Description != newFunction.Description {
+		return true
+	}
+	if oldFunction.DeprecationMessage != newFunction.DeprecationMessage {
+		return true
+	}
+	if len(oldFunction.Parameters) != len(newFunction.Parameters) {
+		return true
+	}
+	for i := range oldFunction.Parameters {
+		if functionParameterChanged(oldFunction.Parameters[i], newFunction.Parameters[i]) {
+			return true
+		}
+	}
+	if functionParameterChanged(oldFunction.VariadicParameter, newFunction.VariadicParameter) {
+		return true
+	}
+	if (oldFunction.Return == nil) != (newFunction.Return == nil) {
+		return true
+	}
+	if oldFunction.Return != nil && !TypesEqual(oldFunction.Return.Type, newFunction.Return.Type) {
+		return true
+	}
+	return false
+}
+
+func functionParameterChanged(oldParameter, newParameter *tfprotov5.FunctionParameter) bool {
+	if oldParameter == nil || newParameter == nil {
+		return oldParameter != newParameter
+	}
+	if oldParameter.Name != newParameter.Name {
+		return true
+	}
+	if oldParameter.Description != newParameter.Description {
+		return true
+	}
+	if oldParameter.AllowNullValue != newParameter.AllowNullValue {
+		return true
+	}
+	if oldParameter.AllowUnknownValues != newParameter.AllowUnknownValues {
+		return true
+	}
+	return !TypesEqual(oldParameter.Type, newParameter.Type)
+}
+
+// TypesEqual returns whether two protocol types are equal, treating unset types as equal.
+func TypesEqual(oldType, newType tftypes.Type) bool {
+	if oldType == nil || newType == nil {
+		return oldType == nil && newType == nil
+	}
+	return oldType.Equal(newType)
+}
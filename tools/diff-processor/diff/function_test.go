@@ -0,0 +1,44 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestComputeFunctionsDiff(t *testing.T) {
+	unchanged := &tfprotov5.Function{
+		Parameters: []*tfprotov5.FunctionParameter{{Name: "self_link", Type: tftypes.String}},
+		Return:     &tfprotov5.FunctionReturn{Type: tftypes.String},
+	}
+	oldFunctions := map[string]*tfprotov5.Function{
+		"unchanged": unchanged,
+		"removed":   unchanged,
+		"changed": {
+			Parameters: []*tfprotov5.FunctionParameter{{Name: "self_link", Type: tftypes.String}},
+			Return:     &tfprotov5.FunctionReturn{Type: tftypes.String},
+		},
+	}
+	newFunctions := map[string]*tfprotov5.Function{
+		"unchanged": {
+			Parameters: []*tfprotov5.FunctionParameter{{Name: "self_link", Type: tftypes.String}},
+			Return:     &tfprotov5.FunctionReturn{Type: tftypes.String},
+		},
+		"added": unchanged,
+		"changed": {
+			Parameters: []*tfprotov5.FunctionParameter{{Name: "self_link", Type: tftypes.List{ElementType: tftypes.String}}},
+			Return:     &tfprotov5.FunctionReturn{Type: tftypes.String},
+		},
+	}
+
+	got := computeFunctionsDiff(oldFunctions, newFunctions)
+	for _, name := range []string{"added", "removed", "changed"} {
+		if _, ok := got[name]; !ok {
+			t.Errorf("computeFunctionsDiff() is missing function %s", name)
+		}
+	}
+	if _, ok := got["unchanged"]; ok {
+		t.Errorf("computeFunctionsDiff() unexpectedly reported function unchanged")
+	}
+}
@@ -0,0 +1,200 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+)
+
+// protocolDeprecationMessage is used as the deprecation message of fields converted
+// from the protocol schema, which only records whether a field is deprecated.
+const protocolDeprecationMessage = "Deprecated"
+
+// SchemaSource is the format a ProviderSchema was read from, which determines the
+// details it has.
+type SchemaSource string
+
+const (
+	// SchemaSourceProvider schemas are read from a provider build, or a snapshot of it,
+	// and keep the SDKv2 details of SDKv2 objects.
+	SchemaSourceProvider SchemaSource = ""
+	// SchemaSourceTerraform schemas are read from `terraform providers schema -json`,
+	// which lacks the SDKv2 details (ForceNew, defaults, int types, state upgraders...)
+	// and resource metadata.
+	SchemaSourceTerraform SchemaSource = "terraform"
+)
+
+// ProviderSchema holds the schemas of everything served by a provider, keyed by
+// Terraform type name (or function name for Functions). ResourceMetadata optionally
+// holds the metadata of the provider's resources.
+type ProviderSchema struct {
+	Provider           *schema.Resource
+	Resources          map[string]*schema.Resource
+	DataSources        map[string]*schema.Resource
+	EphemeralResources map[string]*schema.Resource
+	Functions          map[string]*tfprotov5.Function
+	ResourceMetadata   map[string]*metadata.Resource
+	// ProtocolResources holds the names of the Resources converted from the protocol
+	// schema, like those only served by the plugin framework, which lack SDKv2 details
+	// such as state upgraders.
+	ProtocolResources map[string]bool
+	// Source is the format the schema was read from. Schemas from different sources
+	// have different details, so diffing them reports changes that weren't made.
+	Source SchemaSource
+}
+
+// NewProviderSchema builds the ProviderSchema served by the (muxed) provider server.
+// Objects served by the SDKv2 provider keep their SDKv2 schema, which has details
+// (ForceNew, field sets, defaults...) that the protocol schema doesn't; objects only
+// served by the plugin framework are converted from the protocol schema.
+func NewProviderSchema(ctx context.Context, server tfprotov5.ProviderServer, sdkProvider *schema.Provider) (ProviderSchema, error) {
+	resp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return ProviderSchema{}, fmt.Errorf("getting provider schema: %w", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return ProviderSchema{}, fmt.Errorf("getting provider schema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	resources, protocolResources := mergeProtocolSchemas(sdkProvider.ResourcesMap, resp.ResourceSchemas)
+	dataSources, _ := mergeProtocolSchemas(sdkProvider.DataSourcesMap, resp.DataSourceSchemas)
+	ephemeralResources, _ := mergeProtocolSchemas(nil, resp.EphemeralResourceSchemas)
+	providerSchema := ProviderSchema{
+		Provider:           &schema.Resource{Schema: sdkProvider.Schema},
+		Resources:          resources,
+		DataSources:        dataSources,
+		EphemeralResources: ephemeralResources,
+		ProtocolResources:  protocolResources,
+		Functions:          resp.Functions,
+	}
+	if len(sdkProvider.Schema) == 0 && resp.Provider != nil {
+		providerSchema.Provider = ResourceFromProtocolSchema(resp.Provider)
+	}
+	return providerSchema, nil
+}
+
+// mergeProtocolSchemas returns the SDKv2 schema of the objects served by the SDKv2
+// provider and the converted protocol schema of the others, along with their names.
+func mergeProtocolSchemas(sdkResources map[string]*schema.Resource, protocolSchemas map[string]*tfprotov5.Schema) (map[string]*schema.Resource, map[string]bool) {
+	resources := make(map[string]*schema.Resource, len(protocolSchemas))
+	converted := make(map[string]bool)
+	for name, s := range protocolSchemas {
+		if sdkResource, ok := sdkResources[name]; ok {
+			resources[name] = sdkResource
+			continue
+		}
+		resources[name] = ResourceFromProtocolSchema(s)
+		converted[name] = true
+	}
+	return resources, converted
+}
+
+// ResourceFromProtocolSchema converts a protocol schema into an SDKv2 resource so
+// that it can be diffed the same way as SDKv2 resources.
+func ResourceFromProtocolSchema(s *tfprotov5.Schema) *schema.Resource {
+	if s == nil || s.Block == nil {
+		return &schema.Resource{Schema: map[string]*schema.Schema{}}
+	}
+	resource := resourceFromProtocolBlock(s.Block)
+	resource.SchemaVersion = int(s.Version)
+	return resource
+}
+
+func resourceFromProtocolBlock(block *tfprotov5.SchemaBlock) *schema.Resource {
+	resource := &schema.Resource{
+		Schema:      make(map[string]*schema.Schema),
+		Description: block.Description,
+	}
+	if block.Deprecated {
+		resource.DeprecationMessage = protocolDeprecationMessage
+	}
+	for _, attr := range block.Attributes {
+		field := schemaFromProtocolType(attr.Type)
+		field.Required = attr.Required
+		field.Optional = attr.Optional
+		field.Computed = attr.Computed
+		field.Sensitive = attr.Sensitive
+		field.Description = attr.Description
+		if attr.Deprecated {
+			field.Deprecated = protocolDeprecationMessage
+		}
+		resource.Schema[attr.Name] = field
+	}
+	for _, nested := range block.BlockTypes {
+		field := &schema.Schema{
+			Type:     schema.TypeList,
+			Elem:     resourceFromProtocolBlock(nested.Block),
+			MinItems: int(nested.MinItems),
+			MaxItems: int(nested.MaxItems),
+		}
+		switch nested.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeSingle, tfprotov5.SchemaNestedBlockNestingModeGroup:
+			field.MaxItems = 1
+		case tfprotov5.SchemaNestedBlockNestingModeSet:
+			field.Type = schema.TypeSet
+		case tfprotov5.SchemaNestedBlockNestingModeMap:
+			field.Type = schema.TypeMap
+		}
+		if field.MinItems > 0 {
+			field.Required = true
+		} else {
+			field.Optional = true
+		}
+		field.Description = nested.Block.Description
+		if nested.Block.Deprecated {
+			field.Deprecated = protocolDeprecationMessage
+		}
+		resource.Schema[nested.TypeName] = field
+	}
+	return resource
+}
+
+// schemaFromProtocolType returns the SDKv2 schema for values of the given type.
+// Numbers are converted to TypeFloat, as the protocol doesn't distinguish integers.
+func schemaFromProtocolType(t tftypes.Type) *schema.Schema {
+	switch {
+	case t == nil:
+		return &schema.Schema{Type: schema.TypeInvalid}
+	case t.Is(tftypes.String):
+		return &schema.Schema{Type: schema.TypeString}
+	case t.Is(tftypes.Number):
+		return &schema.Schema{Type: schema.TypeFloat}
+	case t.Is(tftypes.Bool):
+		return &schema.Schema{Type: schema.TypeBool}
+	case t.Is(tftypes.List{}):
+		return &schema.Schema{Type: schema.TypeList, Elem: elemFromProtocolType(t.(tftypes.List).ElementType)}
+	case t.Is(tftypes.Set{}):
+		return &schema.Schema{Type: schema.TypeSet, Elem: elemFromProtocolType(t.(tftypes.Set).ElementType)}
+	case t.Is(tftypes.Map{}):
+		return &schema.Schema{Type: schema.TypeMap, Elem: elemFromProtocolType(t.(tftypes.Map).ElementType)}
+	case t.Is(tftypes.Object{}):
+		return &schema.Schema{Type: schema.TypeList, MaxItems: 1, Elem: resourceFromProtocolObject(t.(tftypes.Object))}
+	}
+	return &schema.Schema{Type: schema.TypeInvalid}
+}
+
+func elemFromProtocolType(t tftypes.Type) interface{} {
+	if object, ok := t.(tftypes.Object); ok {
+		return resourceFromProtocolObject(object)
+	}
+	return schemaFromProtocolType(t)
+}
+
+func resourceFromProtocolObject(object tftypes.Object) *schema.Resource {
+	resource := &schema.Resource{Schema: make(map[string]*schema.Schema)}
+	for name, t := range object.AttributeTypes {
+		field := schemaFromProtocolType(t)
+		if _, optional := object.OptionalAttributes[name]; optional {
+			field.Optional = true
+		}
+		resource.Schema[name] = field
+	}
+	return resource
+}
@@ -0,0 +1,293 @@
+package diff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+)
+
+type fakeProviderServer struct {
+	tfprotov5.ProviderServer
+	resp *tfprotov5.GetProviderSchemaResponse
+}
+
+func (s fakeProviderServer) GetProviderSchema(context.Context, *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return s.resp, nil
+}
+
+func TestResourceFromProtocolSchema(t *testing.T) {
+	protocolSchema := &tfprotov5.Schema{
+		Version: 1,
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{Name: "name", Type: tftypes.String, Required: true},
+				{Name: "size", Type: tftypes.Number, Optional: true, Computed: true},
+				{Name: "token", Type: tftypes.String, Computed: true, Sensitive: true},
+				{Name: "labels", Type: tftypes.Map{ElementType: tftypes.String}, Optional: true, Deprecated: true},
+				{Name: "endpoints", Type: tftypes.List{ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{"uri": tftypes.String},
+				}}, Computed: true},
+			},
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{
+				{
+					TypeName: "settings",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+					Block: &tfprotov5.SchemaBlock{
+						Attributes: []*tfprotov5.SchemaAttribute{
+							{Name: "enabled", Type: tftypes.Bool, Optional: true},
+						},
+					},
+				},
+				{
+					TypeName: "rules",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+					MinItems: 1,
+					Block:    &tfprotov5.SchemaBlock{},
+				},
+			},
+		},
+	}
+	want := &schema.Resource{
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name":   {Type: schema.TypeString, Required: true},
+			"size":   {Type: schema.TypeFloat, Optional: true, Computed: true},
+			"token":  {Type: schema.TypeString, Computed: true, Sensitive: true},
+			"labels": {Type: schema.TypeMap, Elem: &schema.Schema{Type: schema.TypeString}, Optional: true, Deprecated: protocolDeprecationMessage},
+			"endpoints": {
+				Type: schema.TypeList,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"uri": {Type: schema.TypeString},
+				}},
+				Computed: true,
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"enabled": {Type: schema.TypeBool, Optional: true},
+				}},
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				MinItems: 1,
+				Required: true,
+				Elem:     &schema.Resource{Schema: map[string]*schema.Schema{}},
+			},
+		},
+	}
+
+	got := ResourceFromProtocolSchema(protocolSchema)
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(schema.Resource{})); diff != "" {
+		t.Errorf("ResourceFromProtocolSchema() unexpected diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestNewProviderSchema(t *testing.T) {
+	sdkResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true, ForceNew: true},
+		},
+	}
+	sdkProvider := &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"project": {Type: schema.TypeString, Optional: true},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"google_sdk_resource": sdkResource,
+		},
+	}
+	nameSchema := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{Name: "name", Type: tftypes.String, Required: true},
+			},
+		},
+	}
+	server := fakeProviderServer{
+		resp: &tfprotov5.GetProviderSchemaResponse{
+			Provider: &tfprotov5.Schema{Block: &tfprotov5.SchemaBlock{}},
+			ResourceSchemas: map[string]*tfprotov5.Schema{
+				"google_sdk_resource":       nameSchema,
+				"google_framework_resource": nameSchema,
+			},
+			DataSourceSchemas: map[string]*tfprotov5.Schema{
+				"google_framework_data_source": nameSchema,
+			},
+			EphemeralResourceSchemas: map[string]*tfprotov5.Schema{
+				"google_framework_ephemeral_resource": nameSchema,
+			},
+		},
+	}
+
+	got, err := NewProviderSchema(context.Background(), server, sdkProvider)
+	if err != nil {
+		t.Fatalf("NewProviderSchema() returned error: %v", err)
+	}
+	if got.Resources["google_sdk_resource"] != sdkResource {
+		t.Errorf("NewProviderSchema() didn't use the SDKv2 schema of google_sdk_resource")
+	}
+	if _, ok := got.Resources["google_framework_resource"]; !ok {
+		t.Errorf("NewProviderSchema() is missing google_framework_resource")
+	}
+	if _, ok := got.DataSources["google_framework_data_source"]; !ok {
+		t.Errorf("NewProviderSchema() is missing google_framework_data_source")
+	}
+	if _, ok := got.EphemeralResources["google_framework_ephemeral_resource"]; !ok {
+		t.Errorf("NewProviderSchema() is missing google_framework_ephemeral_resource")
+	}
+	if _, ok := got.Provider.Schema["project"]; !ok {
+		t.Errorf("NewProviderSchema() is missing provider field project")
+	}
+	if want := map[string]bool{"google_framework_resource": true}; !cmp.Equal(want, got.ProtocolResources) {
+		t.Errorf("NewProviderSchema() protocol resources = %v, want %v", got.ProtocolResources, want)
+	}
+}
+
+func TestNewProviderSchemaError(t *testing.T) {
+	server := fakeProviderServer{
+		resp: &tfprotov5.GetProviderSchemaResponse{
+			Diagnostics: []*tfprotov5.Diagnostic{
+				{Severity: tfprotov5.DiagnosticSeverityError, Summary: "boom"},
+			},
+		},
+	}
+	if _, err := NewProviderSchema(context.Background(), server, &schema.Provider{}); err == nil {
+		t.Errorf("NewProviderSchema() returned no error for error diagnostics")
+	}
+}
+
+func TestComputeProviderSchemaDiff(t *testing.T) {
+	oldSchema := ProviderSchema{
+		Provider: &schema.Resource{Schema: map[string]*schema.Schema{
+			"project": {Type: schema.TypeString, Optional: true},
+		}},
+		DataSources: map[string]*schema.Resource{
+			"google_x": {Schema: map[string]*schema.Schema{
+				"field_a": {Type: schema.TypeString, Optional: true},
+			}},
+		},
+	}
+	newSchema := ProviderSchema{
+		Provider: &schema.Resource{Schema: map[string]*schema.Schema{
+			"project": {Type: schema.TypeString, Required: true},
+		}},
+		DataSources: map[string]*schema.Resource{
+			"google_x": {Schema: map[string]*schema.Schema{
+				"field_a": {Type: schema.TypeString, Optional: true},
+			}},
+		},
+		EphemeralResources: map[string]*schema.Resource{
+			"google_y": {Schema: map[string]*schema.Schema{}},
+		},
+	}
+
+	got := ComputeProviderSchemaDiff(oldSchema, newSchema)
+	if _, ok := got.Provider.Fields["project"]; !ok || len(got.Provider.Fields) != 1 {
+		t.Errorf("ComputeProviderSchemaDiff() unexpected provider fields: %v", got.Provider.Fields)
+	}
+	if len(got.DataSources) != 0 {
+		t.Errorf("ComputeProviderSchemaDiff() unexpected data source diff: %v", got.DataSources)
+	}
+	if d, ok := got.EphemeralResources["google_y"]; !ok || d.ResourceConfig.Old != nil {
+		t.Errorf("ComputeProviderSchemaDiff() expected google_y to be added, got %v", got.EphemeralResources)
+	}
+}
+
+func TestComputeProviderSchemaDiffMetadata(t *testing.T) {
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Required: true},
+	}}
+	oldSchema := ProviderSchema{
+		Resources: map[string]*schema.Resource{"google_x": resource, "google_y": resource},
+		ResourceMetadata: map[string]*metadata.Resource{
+			"google_x": {Resource: "google_x", IdFormat: "projects/{{project}}/xs/{{name}}"},
+			"google_y": {Resource: "google_y", IdFormat: "projects/{{project}}/ys/{{name}}"},
+		},
+	}
+	newSchema := ProviderSchema{
+		Resources: map[string]*schema.Resource{"google_x": resource, "google_y": resource},
+		ResourceMetadata: map[string]*metadata.Resource{
+			"google_x": {Resource: "google_x", IdFormat: "{{project}}/{{name}}"},
+			"google_y": {Resource: "google_y", IdFormat: "projects/{{project}}/ys/{{name}}", SourceFile: "products/y/Y.yaml"},
+		},
+	}
+
+	got := ComputeProviderSchemaDiff(oldSchema, newSchema)
+	d, ok := got.Resources["google_x"]
+	if !ok {
+		t.Fatalf("ComputeProviderSchemaDiff() expected google_x to be modified, got %v", got.Resources)
+	}
+	if d.ResourceConfig.OldMetadata.IdFormat != "projects/{{project}}/xs/{{name}}" || d.ResourceConfig.NewMetadata.IdFormat != "{{project}}/{{name}}" {
+		t.Errorf("ComputeProviderSchemaDiff() unexpected metadata diff: %v", d.ResourceConfig)
+	}
+	if _, ok := got.Resources["google_y"]; ok {
+		t.Errorf("ComputeProviderSchemaDiff() expected google_y to be unchanged, got %v", got.Resources["google_y"])
+	}
+}
+
+func TestComputeProviderSchemaDiffFieldConstraints(t *testing.T) {
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{
+		"tier": {Type: schema.TypeString, Optional: true},
+	}}
+	oldSchema := ProviderSchema{
+		Resources: map[string]*schema.Resource{"google_x": resource},
+		ResourceMetadata: map[string]*metadata.Resource{
+			"google_x": {Resource: "google_x", Fields: []metadata.Field{
+				{Field: "tier", Constraints: metadata.Constraints{EnumValues: []string{"BASIC", "PREMIUM"}}},
+			}},
+		},
+	}
+	newSchema := ProviderSchema{
+		Resources: map[string]*schema.Resource{"google_x": resource},
+		ResourceMetadata: map[string]*metadata.Resource{
+			"google_x": {Resource: "google_x", Fields: []metadata.Field{
+				{Field: "tier", Constraints: metadata.Constraints{EnumValues: []string{"BASIC"}}},
+			}},
+		},
+	}
+
+	got := ComputeProviderSchemaDiff(oldSchema, newSchema)
+	fieldDiff, ok := got.Resources["google_x"].Fields["tier"]
+	if !ok {
+		t.Fatalf("ComputeProviderSchemaDiff() expected google_x.tier to be modified, got %v", got.Resources)
+	}
+	if fieldDiff.Old != resource.Schema["tier"] || fieldDiff.New != resource.Schema["tier"] {
+		t.Errorf("ComputeProviderSchemaDiff() unexpected field schemas: %v", fieldDiff)
+	}
+	if fieldDiff.OldMetadata == nil || fieldDiff.NewMetadata == nil || len(fieldDiff.NewMetadata.EnumValues) != 1 {
+		t.Errorf("ComputeProviderSchemaDiff() unexpected field metadata: %v", fieldDiff)
+	}
+}
+
+func TestComputeProviderSchemaDiffProtocolResources(t *testing.T) {
+	oldSchema := ProviderSchema{
+		Resources: map[string]*schema.Resource{
+			"google_x": {Schema: map[string]*schema.Schema{}},
+			"google_y": {Schema: map[string]*schema.Schema{}},
+		},
+	}
+	newSchema := ProviderSchema{
+		Resources: map[string]*schema.Resource{
+			"google_x": {SchemaVersion: 1, Schema: map[string]*schema.Schema{}},
+			"google_y": {SchemaVersion: 1, Schema: map[string]*schema.Schema{}},
+		},
+		ProtocolResources: map[string]bool{"google_x": true},
+	}
+
+	got := ComputeProviderSchemaDiff(oldSchema, newSchema)
+	if config := got.Resources["google_x"].ResourceConfig; config.OldFromProtocol || !config.NewFromProtocol {
+		t.Errorf("ComputeProviderSchemaDiff() google_x from protocol = %v, %v, want false, true", config.OldFromProtocol, config.NewFromProtocol)
+	}
+	if config := got.Resources["google_y"].ResourceConfig; config.OldFromProtocol || config.NewFromProtocol {
+		t.Errorf("ComputeProviderSchemaDiff() google_y from protocol = %v, %v, want false, false", config.OldFromProtocol, config.NewFromProtocol)
+	}
+}
@@ -0,0 +1,134 @@
+package metadata
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// metaFileSuffix is the suffix of the metadata files shipped alongside each resource,
+// for example `resource_pubsub_topic_generated_meta.yaml`.
+const metaFileSuffix = "_meta.yaml"
+
+// Resource is the metadata of a resource, as read from its `*_meta.yaml` file.
+type Resource struct {
+	Resource            string   `yaml:"resource" json:"resource"`
+	GenerationType      string   `yaml:"generation_type" json:"generation_type"`
+	SourceFile          string   `yaml:"source_file,omitempty" json:"source_file,omitempty"`
+	ApiServiceName      string   `yaml:"api_service_name" json:"api_service_name"`
+	ApiVersion          string   `yaml:"api_version,omitempty" json:"api_version,omitempty"`
+	ApiResourceTypeKind string   `yaml:"api_resource_type_kind,omitempty" json:"api_resource_type_kind,omitempty"`
+	AutogenStatus       bool     `yaml:"autogen_status,omitempty" json:"autogen_status,omitempty"`
+	IdFormat            string   `yaml:"id_format,omitempty" json:"id_format,omitempty"`
+	ImportFormats       []string `yaml:"import_formats,omitempty" json:"import_formats,omitempty"`
+	Fields              []Field  `yaml:"fields,omitempty" json:"fields,omitempty"`
+
+	// Path is the file the metadata was read from.
+	Path string `yaml:"-" json:"path,omitempty"`
+}
+
+// Field is the metadata of a single (flattened) field of a resource.
+type Field struct {
+	Field        string `yaml:"field" json:"field"`
+	ApiField     string `yaml:"api_field,omitempty" json:"api_field,omitempty"`
+	ProviderOnly bool   `yaml:"provider_only,omitempty" json:"provider_only,omitempty"`
+	Constraints  `yaml:",inline"`
+
+	// Line is the line of the field in the metadata file, if it was read from one.
+	Line int `yaml:"-" json:"line,omitempty"`
+}
+
+// Constraints are the values a field (or each of its items, for arrays) accepts.
+// Min and Max are the bounds of the value of numbers and of the length of strings,
+// as written in the provider's validation function.
+type Constraints struct {
+	EnumValues      []string `yaml:"enum_values,omitempty" json:"enum_values,omitempty"`
+	ValidationRegex string   `yaml:"validation_regex,omitempty" json:"validation_regex,omitempty"`
+	Min             string   `yaml:"min,omitempty" json:"min,omitempty"`
+	Max             string   `yaml:"max,omitempty" json:"max,omitempty"`
+}
+
+// FieldsByName returns the fields of the resource keyed by their flattened name.
+func (r *Resource) FieldsByName() map[string]*Field {
+	if r == nil {
+		return nil
+	}
+	fields := make(map[string]*Field, len(r.Fields))
+	for i := range r.Fields {
+		fields[r.Fields[i].Field] = &r.Fields[i]
+	}
+	return fields
+}
+
+// Read parses the metadata file at path.
+func Read(path string) (*Resource, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	r := &Resource{Path: path}
+	if len(doc.Content) == 0 {
+		return r, nil
+	}
+	if err := doc.Content[0].Decode(r); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	if fields := mappingValue(doc.Content[0], "fields"); fields != nil && len(fields.Content) == len(r.Fields) {
+		for i, field := range fields.Content {
+			r.Fields[i].Line = field.Line
+		}
+	}
+	return r, nil
+}
+
+// mappingValue returns the value of key in a yaml mapping node, or nil if it's missing.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// ReadDir reads all metadata files found (recursively) in dir, keyed by resource name.
+// A missing dir is treated as having no metadata.
+func ReadDir(dir string) (map[string]*Resource, error) {
+	resources := make(map[string]*Resource)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), metaFileSuffix) {
+			return nil
+		}
+		r, err := Read(path)
+		if err != nil {
+			return err
+		}
+		if r.Resource == "" {
+			return nil
+		}
+		resources[r.Resource] = r
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return resources, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
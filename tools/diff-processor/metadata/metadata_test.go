@@ -0,0 +1,62 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadDir(t *testing.T) {
+	got, err := ReadDir("../testdata/google/services")
+	if err != nil {
+		t.Fatalf("ReadDir() returned error: %v", err)
+	}
+	want := map[string]*Resource{
+		"google_pubsub_topic": {
+			Resource:            "google_pubsub_topic",
+			GenerationType:      "mmv1",
+			SourceFile:          "products/pubsub/Topic.yaml",
+			ApiServiceName:      "pubsub.googleapis.com",
+			ApiVersion:          "v1",
+			ApiResourceTypeKind: "Topic",
+			IdFormat:            "projects/{{project}}/topics/{{name}}",
+			ImportFormats: []string{
+				"projects/{{project}}/topics/{{name}}",
+				"{{project}}/{{name}}",
+				"{{name}}",
+			},
+			Fields: []Field{
+				{Field: "effective_labels", ProviderOnly: true, Line: 13},
+				{Field: "name", Constraints: Constraints{ValidationRegex: "^[a-z]+$"}, Line: 15},
+				{Field: "message_storage_policy.allowed_persistence_regions", Line: 17},
+				{Field: "project", ProviderOnly: true, Line: 18},
+			},
+			Path: "../testdata/google/services/pubsub/resource_pubsub_topic_generated_meta.yaml",
+		},
+		"google_pubsub_lite_reservation": {
+			Resource:            "google_pubsub_lite_reservation",
+			GenerationType:      "handwritten",
+			ApiServiceName:      "pubsublite.googleapis.com",
+			ApiVersion:          "v1",
+			ApiResourceTypeKind: "Reservation",
+			Fields: []Field{
+				{Field: "name", Line: 7},
+				{Field: "throughput_capacity", ApiField: "throughputCapacity", Constraints: Constraints{Min: "1", Max: "64"}, Line: 8},
+			},
+			Path: "../testdata/google/services/pubsub/resource_pubsub_lite_reservation_meta.yaml",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ReadDir() unexpected diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestReadDirMissing(t *testing.T) {
+	got, err := ReadDir("../testdata/does-not-exist")
+	if err != nil {
+		t.Fatalf("ReadDir() returned error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("ReadDir() = %v, want no metadata", got)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	newFwprovider "google/provider/new/google/fwprovider"
+	newProvider "google/provider/new/google/provider"
+	oldFwprovider "google/provider/old/google/fwprovider"
+	oldProvider "google/provider/old/google/provider"
+
+	"context"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The services directories of the old and new provider clones, relative to
+// diff-processor, which hold the `*_meta.yaml` files of their resources.
+const (
+	oldServicesDir = "old/google/services"
+	newServicesDir = "new/google/services"
+)
+
+// readOldCompiledSchema reads the full schema served by the old muxed provider
+// compiled into diff-processor, covering both the SDKv2 and plugin framework
+// providers, along with the metadata of its resources.
+func readOldCompiledSchema() (diff.ProviderSchema, error) {
+	primary := oldProvider.Provider()
+	return readCompiledSchema(primary, oldFwprovider.New(primary), oldServicesDir)
+}
+
+// readNewCompiledSchema is readOldCompiledSchema for the new provider.
+func readNewCompiledSchema() (diff.ProviderSchema, error) {
+	primary := newProvider.Provider()
+	return readCompiledSchema(primary, newFwprovider.New(primary), newServicesDir)
+}
+
+func readCompiledSchema(primary *schema.Provider, framework provider.Provider, servicesDir string) (diff.ProviderSchema, error) {
+	ctx := context.Background()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, primary.GRPCProvider, providerserver.NewProtocol5(framework))
+	if err != nil {
+		return diff.ProviderSchema{}, fmt.Errorf("error creating mux server: %w", err)
+	}
+	providerSchema, err := diff.NewProviderSchema(ctx, muxServer.ProviderServer(), primary)
+	if err != nil {
+		return diff.ProviderSchema{}, err
+	}
+	providerSchema.ResourceMetadata, err = metadata.ReadDir(servicesDir)
+	if err != nil {
+		return diff.ProviderSchema{}, fmt.Errorf("error reading resource metadata: %w", err)
+	}
+	return providerSchema, nil
+}
@@ -0,0 +1,25 @@
+module github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/providers
+
+go 1.23
+
+replace google/provider/old => ../old
+
+replace google/provider/new => ../new
+
+replace github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor => ../
+
+replace github.com/GoogleCloudPlatform/magic-modules/tools/issue-labeler => ../../issue-labeler
+
+replace github.com/GoogleCloudPlatform/magic-modules/tools/test-reader => ../../test-reader
+
+replace github.com/hashicorp/go-changelog => ../../go-changelog
+
+require (
+	github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor v0.0.0-00010101000000-000000000000
+	github.com/google/go-cmp v0.6.0
+	github.com/hashicorp/terraform-plugin-framework v1.13.0
+	github.com/hashicorp/terraform-plugin-mux v0.17.0
+	github.com/hashicorp/terraform-plugin-sdk/v2 v2.36.0
+	google/provider/new v0.0.0-00010101000000-000000000000
+	google/provider/old v0.0.0-00010101000000-000000000000
+)
@@ -0,0 +1,13 @@
+// Command diff-processor is diff-processor with the old and new provider
+// clones compiled in, to read their schemas when no snapshots are given. It is
+// a separate module so that diff-processor itself builds without the clones.
+package main
+
+import (
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/cmd"
+)
+
+func main() {
+	cmd.SetCompiledSchemaReaders(readOldCompiledSchema, readNewCompiledSchema)
+	cmd.Execute()
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"testing"
+
+	newProvider "google/provider/new/google/provider"
+	oldProvider "google/provider/old/google/provider"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceConfigCmpOpts ignores the StateUpgrader fields that the diff doesn't copy.
+var resourceConfigCmpOpts = cmpopts.IgnoreFields(schema.StateUpgrader{}, "Type", "Upgrade")
+
+func TestNewProviderOldProviderChanges(t *testing.T) {
+	changes := diff.ComputeSchemaDiff(oldProvider.ResourceMap(), newProvider.ResourceMap())
+
+	for resource, resourceDiff := range changes {
+		if resourceDiff.ResourceConfig.Old == nil {
+			t.Logf("%s is added", resource)
+			continue
+		}
+		if resourceDiff.ResourceConfig.New == nil {
+			t.Logf("%s is removed", resource)
+			continue
+		}
+		t.Logf("%s is modified", resource)
+		if d := cmp.Diff(resourceDiff.ResourceConfig.Old, resourceDiff.ResourceConfig.New, resourceConfigCmpOpts); d != "" {
+			t.Logf("%s config changes (-old, +new):\n%s", resource, d)
+		}
+		for field, fieldDiff := range resourceDiff.Fields {
+			if fieldDiff.Old == nil {
+				t.Logf("%s.%s is added", resource, field)
+				continue
+			}
+			if fieldDiff.New == nil {
+				t.Logf("%s.%s is removed", resource, field)
+				continue
+			}
+			t.Logf("%s.%s is modified", resource, field)
+			if d := cmp.Diff(fieldDiff.Old, fieldDiff.New); d != "" {
+				t.Logf("%s.%s changes (-old, +new):\n%s", resource, field, d)
+			}
+		}
+	}
+}
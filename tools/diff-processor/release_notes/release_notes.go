@@ -0,0 +1,204 @@
+package release_notes
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/breaking_changes"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+	changelog "github.com/hashicorp/go-changelog"
+)
+
+// providerProduct is the product of release notes for cross-product changes, like
+// changes to the provider configuration or provider functions.
+const providerProduct = "provider"
+
+// ComputeNotes drafts the release notes of a provider schema diff following
+// https://googlecloudplatform.github.io/magic-modules/code-review/release-notes/:
+// new resources and data sources, new fields, deprecations and the blocking or
+// acknowledged breaking changes. Informational breaking changes are left out, as
+// they are covered by the deprecation notes.
+func ComputeNotes(schemaDiff diff.ProviderSchemaDiff, breakingChanges []breaking_changes.BreakingChange) []changelog.Note {
+	products := newProducts(schemaDiff.Resources)
+
+	notes := computeSchemaNotes(schemaDiff.Resources, "resource", "new-resource", products)
+	notes = append(notes, computeSchemaNotes(schemaDiff.DataSources, "data source", "new-datasource", products)...)
+	notes = append(notes, computeSchemaNotes(schemaDiff.EphemeralResources, "ephemeral resource", "", products)...)
+
+	for function, functionDiff := range schemaDiff.Functions {
+		if functionDiff.Old == nil && functionDiff.New != nil {
+			notes = append(notes, changelog.Note{
+				Type: "note",
+				Body: fmt.Sprintf("%s: added `provider::google::%s` function", providerProduct, function),
+			})
+		}
+	}
+
+	for _, breakingChange := range breakingChanges {
+		if breakingChange.Severity == breaking_changes.SeverityInformational {
+			continue
+		}
+		notes = append(notes, changelog.Note{
+			Type: "breaking-change",
+			Body: fmt.Sprintf("%s: %s", products.of(breakingChange.Resource), lowerFirst(singleLine(breakingChange.Message))),
+		})
+	}
+
+	sort.Slice(notes, changelog.SortNotes(notes))
+	return notes
+}
+
+// computeSchemaNotes drafts the notes of added objects, added fields and deprecations
+// of a kind of object. Objects without a newType (like ephemeral resources) get a
+// plain note when they're added.
+func computeSchemaNotes(schemaDiff diff.SchemaDiff, kind, newType string, products products) []changelog.Note {
+	var notes []changelog.Note
+	for name, resourceDiff := range schemaDiff {
+		product := products.of(name)
+		oldConfig, newConfig := resourceDiff.ResourceConfig.Old, resourceDiff.ResourceConfig.New
+		if newConfig == nil {
+			continue
+		}
+		if oldConfig == nil {
+			if newType == "" {
+				notes = append(notes, changelog.Note{
+					Type: "note",
+					Body: fmt.Sprintf("%s: added `%s` %s", product, name, kind),
+				})
+			} else {
+				notes = append(notes, changelog.Note{Type: newType, Body: fmt.Sprintf("`%s`", name)})
+			}
+			continue
+		}
+
+		if oldConfig.DeprecationMessage == "" && newConfig.DeprecationMessage != "" {
+			notes = append(notes, changelog.Note{
+				Type: "deprecation",
+				Body: withReason(fmt.Sprintf("%s: deprecated `%s` %s", product, name, kind), newConfig.DeprecationMessage),
+			})
+		}
+
+		var addedFields []string
+		for field, fieldDiff := range resourceDiff.Fields {
+			if fieldDiff.New == nil {
+				continue
+			}
+			if fieldDiff.Old == nil {
+				// Fields within an added block are covered by the note of the block.
+				if parent, ok := resourceDiff.Fields[parentField(field)]; !ok || parent.Old != nil {
+					addedFields = append(addedFields, field)
+				}
+				continue
+			}
+			if fieldDiff.Old.Deprecated == "" && fieldDiff.New.Deprecated != "" {
+				notes = append(notes, changelog.Note{
+					Type: "deprecation",
+					Body: withReason(fmt.Sprintf("%s: deprecated `%s` field on `%s` %s", product, field, name, kind), fieldDiff.New.Deprecated),
+				})
+			}
+		}
+		if len(addedFields) > 0 {
+			sort.Strings(addedFields)
+			notes = append(notes, changelog.Note{
+				Type: "enhancement",
+				Body: fmt.Sprintf("%s: added %s to `%s` %s", product, fieldList(addedFields), name, kind),
+			})
+		}
+	}
+	return notes
+}
+
+// NewEntry renders notes as the release note blocks of a pull request description.
+// An empty list of notes is rendered as a `none` release note.
+func NewEntry(notes []changelog.Note) changelog.Entry {
+	if len(notes) == 0 {
+		return changelog.Entry{Body: "```release-note:none\n```\n"}
+	}
+	var blocks []string
+	for _, note := range notes {
+		blocks = append(blocks, fmt.Sprintf("```release-note:%s\n%s\n```\n", note.Type, note.Body))
+	}
+	return changelog.Entry{Body: strings.Join(blocks, "\n")}
+}
+
+// products finds the product of resources from their metadata.
+type products map[string]*metadata.Resource
+
+func newProducts(schemaDiff diff.SchemaDiff) products {
+	p := make(products)
+	for name, resourceDiff := range schemaDiff {
+		if resourceDiff.ResourceConfig.NewMetadata != nil {
+			p[name] = resourceDiff.ResourceConfig.NewMetadata
+		} else if resourceDiff.ResourceConfig.OldMetadata != nil {
+			p[name] = resourceDiff.ResourceConfig.OldMetadata
+		}
+	}
+	return p
+}
+
+// of returns the product of a resource: the folder of its yaml files for MMv1
+// resources, or its API subdomain for handwritten resources. Data sources and
+// ephemeral resources use the metadata of the resource with the same name, and
+// objects without metadata fall back to the first word of their name after `google_`.
+func (p products) of(name string) string {
+	if name == "" || strings.HasPrefix(name, "provider::") {
+		return providerProduct
+	}
+	name = strings.TrimPrefix(name, "ephemeral.")
+	if m, ok := p[name]; ok {
+		if m.SourceFile != "" {
+			return path.Base(path.Dir(m.SourceFile))
+		}
+		if subdomain, _, ok := strings.Cut(m.ApiServiceName, "."); ok {
+			return subdomain
+		}
+	}
+	product, _, _ := strings.Cut(strings.TrimPrefix(name, "google_"), "_")
+	return product
+}
+
+func parentField(field string) string {
+	if i := strings.LastIndex(field, "."); i >= 0 {
+		return field[:i]
+	}
+	return ""
+}
+
+// fieldList lists fields the way release notes do, e.g. "`a`, `b`, and `c` fields".
+func fieldList(fields []string) string {
+	quoted := make([]string, len(fields))
+	for i, field := range fields {
+		quoted[i] = fmt.Sprintf("`%s`", field)
+	}
+	switch len(quoted) {
+	case 1:
+		return quoted[0] + " field"
+	case 2:
+		return quoted[0] + " and " + quoted[1] + " fields"
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + ", and " + quoted[len(quoted)-1] + " fields"
+}
+
+// withReason appends a deprecation message to a note if it fits on a single line.
+func withReason(body, reason string) string {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || strings.Contains(reason, "\n") {
+		return body
+	}
+	return body + ". " + reason
+}
+
+// singleLine joins the lines of a message, as release notes can't span lines.
+func singleLine(message string) string {
+	return strings.Join(strings.Fields(message), " ")
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,191 @@
+package release_notes
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/breaking_changes"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+	"github.com/google/go-cmp/cmp"
+	changelog "github.com/hashicorp/go-changelog"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestComputeNotes(t *testing.T) {
+	cases := map[string]struct {
+		schemaDiff      diff.ProviderSchemaDiff
+		breakingChanges []breaking_changes.BreakingChange
+		want            []changelog.Note
+	}{
+		"no changes": {},
+		"new resource and data source": {
+			schemaDiff: diff.ProviderSchemaDiff{
+				Resources: diff.SchemaDiff{
+					"google_pubsub_topic": {
+						ResourceConfig: diff.ResourceConfigDiff{New: &schema.Resource{}},
+						Fields: map[string]diff.FieldDiff{
+							"name": {New: &schema.Schema{}},
+						},
+					},
+				},
+				DataSources: diff.SchemaDiff{
+					"google_pubsub_topic": {
+						ResourceConfig: diff.ResourceConfigDiff{New: &schema.Resource{}},
+					},
+				},
+			},
+			want: []changelog.Note{
+				{Type: "new-datasource", Body: "`google_pubsub_topic`"},
+				{Type: "new-resource", Body: "`google_pubsub_topic`"},
+			},
+		},
+		"new fields use the product of the resource metadata": {
+			schemaDiff: diff.ProviderSchemaDiff{
+				Resources: diff.SchemaDiff{
+					"google_pubsub_topic": {
+						ResourceConfig: diff.ResourceConfigDiff{
+							Old:         &schema.Resource{},
+							New:         &schema.Resource{},
+							NewMetadata: &metadata.Resource{SourceFile: "products/pubsub/Topic.yaml"},
+						},
+						Fields: map[string]diff.FieldDiff{
+							"ingestion_data_source_settings":             {New: &schema.Schema{}},
+							"ingestion_data_source_settings.aws_kinesis": {New: &schema.Schema{}},
+						},
+					},
+					"google_pubsub_lite_reservation": {
+						ResourceConfig: diff.ResourceConfigDiff{
+							Old:         &schema.Resource{},
+							New:         &schema.Resource{},
+							NewMetadata: &metadata.Resource{ApiServiceName: "pubsublite.googleapis.com"},
+						},
+						Fields: map[string]diff.FieldDiff{
+							"throughput_capacity": {Old: &schema.Schema{}, New: &schema.Schema{}},
+							"labels":              {New: &schema.Schema{}},
+							"region":              {New: &schema.Schema{}},
+							"zone":                {New: &schema.Schema{}},
+						},
+					},
+				},
+				DataSources: diff.SchemaDiff{
+					"google_pubsub_lite_reservation": {
+						ResourceConfig: diff.ResourceConfigDiff{Old: &schema.Resource{}, New: &schema.Resource{}},
+						Fields: map[string]diff.FieldDiff{
+							"labels": {New: &schema.Schema{}},
+							"region": {New: &schema.Schema{}},
+						},
+					},
+				},
+			},
+			want: []changelog.Note{
+				{Type: "enhancement", Body: "pubsub: added `ingestion_data_source_settings` field to `google_pubsub_topic` resource"},
+				{Type: "enhancement", Body: "pubsublite: added `labels` and `region` fields to `google_pubsub_lite_reservation` data source"},
+				{Type: "enhancement", Body: "pubsublite: added `labels`, `region`, and `zone` fields to `google_pubsub_lite_reservation` resource"},
+			},
+		},
+		"deprecations": {
+			schemaDiff: diff.ProviderSchemaDiff{
+				Resources: diff.SchemaDiff{
+					"google_container_unicorn": {
+						ResourceConfig: diff.ResourceConfigDiff{Old: &schema.Resource{}, New: &schema.Resource{}},
+						Fields: map[string]diff.FieldDiff{
+							"region": {Old: &schema.Schema{}, New: &schema.Schema{Deprecated: "Use `location` instead."}},
+							"zone":   {Old: &schema.Schema{}, New: &schema.Schema{Deprecated: "Use `location`\ninstead."}},
+						},
+					},
+					"google_container_pegasus": {
+						ResourceConfig: diff.ResourceConfigDiff{
+							Old: &schema.Resource{},
+							New: &schema.Resource{DeprecationMessage: "`google_container_pegasus` will be removed in the next major release."},
+						},
+					},
+				},
+			},
+			want: []changelog.Note{
+				{Type: "deprecation", Body: "container: deprecated `google_container_pegasus` resource. `google_container_pegasus` will be removed in the next major release."},
+				{Type: "deprecation", Body: "container: deprecated `region` field on `google_container_unicorn` resource. Use `location` instead."},
+				{Type: "deprecation", Body: "container: deprecated `zone` field on `google_container_unicorn` resource"},
+			},
+		},
+		"ephemeral resources and functions": {
+			schemaDiff: diff.ProviderSchemaDiff{
+				EphemeralResources: diff.SchemaDiff{
+					"google_service_account_access_token": {
+						ResourceConfig: diff.ResourceConfigDiff{New: &schema.Resource{}},
+					},
+				},
+				Functions: map[string]diff.FunctionDiff{
+					"location_from_id": {New: &tfprotov5.Function{}},
+				},
+			},
+			want: []changelog.Note{
+				{Type: "note", Body: "provider: added `provider::google::location_from_id` function"},
+				{Type: "note", Body: "service: added `google_service_account_access_token` ephemeral resource"},
+			},
+		},
+		"breaking changes": {
+			breakingChanges: []breaking_changes.BreakingChange{
+				{
+					Resource: "google_pubsub_topic",
+					Field:    "message_retention_duration",
+					Message:  "Field `message_retention_duration` within resource `google_pubsub_topic` was either removed or renamed",
+					Severity: breaking_changes.SeverityAcknowledged,
+				},
+				{
+					Field:    "project",
+					Message:  "Provider config field `project` changed from optional to required",
+					Severity: breaking_changes.SeverityBlocking,
+				},
+				{
+					Resource: "google_pubsub_topic",
+					Field:    "labels",
+					Message:  "Field `labels` within resource `google_pubsub_topic` is newly deprecated",
+					Severity: breaking_changes.SeverityInformational,
+				},
+			},
+			want: []changelog.Note{
+				{Type: "breaking-change", Body: "provider: provider config field `project` changed from optional to required"},
+				{Type: "breaking-change", Body: "pubsub: field `message_retention_duration` within resource `google_pubsub_topic` was either removed or renamed"},
+			},
+		},
+	}
+	for tn, tc := range cases {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+			got := ComputeNotes(tc.schemaDiff, tc.breakingChanges)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ComputeNotes() unexpected diff (-want, +got):\n%s", diff)
+			}
+			for _, note := range got {
+				if err := note.Validate(); err != nil {
+					t.Errorf("ComputeNotes() returned invalid note %v: %v", note, err)
+				}
+			}
+		})
+	}
+}
+
+func TestNewEntry(t *testing.T) {
+	cases := map[string][]changelog.Note{
+		"no notes": nil,
+		"notes": {
+			{Type: "enhancement", Body: "pubsub: added `labels` field to `google_pubsub_topic` resource"},
+			{Type: "new-resource", Body: "`google_pubsub_schema`"},
+		},
+	}
+	for tn, notes := range cases {
+		entry := NewEntry(notes)
+		if errs := entry.Validate(); len(errs) > 0 {
+			t.Errorf("%s: NewEntry() returned an invalid entry:\n%s\n%v", tn, entry.Body, errs)
+		}
+		want := notes
+		if len(notes) == 0 {
+			want = []changelog.Note{{Type: "none"}}
+		}
+		if diff := cmp.Diff(want, changelog.NotesFromEntry(entry)); diff != "" {
+			t.Errorf("%s: NewEntry() notes unexpected diff (-want, +got):\n%s", tn, diff)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package release_notes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/breaking_changes"
+)
+
+// upgradeGuidePlaceholder is left in sections without a reviewed reason, for the
+// author to describe how users should update their configurations.
+const upgradeGuidePlaceholder = "<!-- Describe why this changed and how users should update their configurations. -->"
+
+// UpgradeGuide drafts the sections of the major release upgrade guide covering the
+// blocking and acknowledged breaking changes, grouped by the object they affect. The
+// reason of the exemption that acknowledged a change, if any, is used as its description.
+func UpgradeGuide(breakingChanges []breaking_changes.BreakingChange) string {
+	sections := make(map[string][]breaking_changes.BreakingChange)
+	for _, breakingChange := range breakingChanges {
+		if breakingChange.Severity == breaking_changes.SeverityInformational {
+			continue
+		}
+		heading := upgradeGuideHeading(breakingChange)
+		sections[heading] = append(sections[heading], breakingChange)
+	}
+
+	headings := make([]string, 0, len(sections))
+	for heading := range sections {
+		headings = append(headings, heading)
+	}
+	sort.Strings(headings)
+
+	var sb strings.Builder
+	for _, heading := range headings {
+		changes := sections[heading]
+		sort.Slice(changes, func(i, j int) bool {
+			return changes[i].Message < changes[j].Message
+		})
+		fmt.Fprintf(&sb, "## %s\n\n", heading)
+		for _, change := range changes {
+			description := upgradeGuidePlaceholder
+			if change.Exemption != nil {
+				description = strings.TrimSpace(change.Exemption.Reason)
+			}
+			fmt.Fprintf(&sb, "### %s\n\n%s\n\n", singleLine(change.Message), description)
+		}
+	}
+	return sb.String()
+}
+
+// upgradeGuideHeading returns the heading of the section of the object affected by a
+// breaking change, following the existing upgrade guides.
+func upgradeGuideHeading(breakingChange breaking_changes.BreakingChange) string {
+	resource := breakingChange.Resource
+	switch {
+	case resource == "":
+		return "Provider"
+	case strings.HasPrefix(resource, "provider::"):
+		return fmt.Sprintf("Function: `%s`", resource)
+	case strings.HasPrefix(resource, "ephemeral."):
+		return fmt.Sprintf("Ephemeral resource: `%s`", strings.TrimPrefix(resource, "ephemeral."))
+	case strings.HasPrefix(breakingChange.RuleName, "data-source-"):
+		return fmt.Sprintf("Data source: `%s`", resource)
+	}
+	return fmt.Sprintf("Resource: `%s`", resource)
+}
@@ -0,0 +1,73 @@
+package release_notes
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/breaking_changes"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUpgradeGuide(t *testing.T) {
+	breakingChanges := []breaking_changes.BreakingChange{
+		{
+			Resource: "google_pubsub_topic",
+			Field:    "message_retention_duration",
+			Message:  "Field `message_retention_duration` within resource `google_pubsub_topic` was either removed or renamed",
+			RuleName: "resource-schema-field-removal-or-rename",
+			Severity: breaking_changes.SeverityAcknowledged,
+			Exemption: &breaking_changes.Exemption{
+				Reason: "Removed in favor of `message_retention_period` in the 7.0.0 major release",
+			},
+		},
+		{
+			Resource: "google_pubsub_topic",
+			Field:    "kms_key_name",
+			Message:  "Field `kms_key_name` changed from optional to required on `google_pubsub_topic`",
+			RuleName: "field-optional-to-required",
+			Severity: breaking_changes.SeverityBlocking,
+		},
+		{
+			Resource: "google_pubsub_topic",
+			Field:    "labels",
+			Message:  "Field `labels` within resource `google_pubsub_topic` is newly deprecated",
+			RuleName: "field-deprecation",
+			Severity: breaking_changes.SeverityInformational,
+		},
+		{
+			Resource: "google_pubsub_topic",
+			Field:    "name",
+			Message:  "Field `name` within data source `google_pubsub_topic` was either removed or renamed",
+			RuleName: "data-source-field-removal-or-rename",
+			Severity: breaking_changes.SeverityBlocking,
+		},
+		{
+			Field:    "project",
+			Message:  "Provider config field `project` changed from optional to required",
+			RuleName: "provider-config-field-optional-to-required",
+			Severity: breaking_changes.SeverityBlocking,
+		},
+		{
+			Resource: "provider::google::location_from_id",
+			Message:  "Function `location_from_id` was either removed or renamed",
+			RuleName: "function-removal-or-rename",
+			Severity: breaking_changes.SeverityBlocking,
+		},
+	}
+	want := "## Data source: `google_pubsub_topic`\n\n" +
+		"### Field `name` within data source `google_pubsub_topic` was either removed or renamed\n\n" +
+		upgradeGuidePlaceholder + "\n\n" +
+		"## Function: `provider::google::location_from_id`\n\n" +
+		"### Function `location_from_id` was either removed or renamed\n\n" +
+		upgradeGuidePlaceholder + "\n\n" +
+		"## Provider\n\n" +
+		"### Provider config field `project` changed from optional to required\n\n" +
+		upgradeGuidePlaceholder + "\n\n" +
+		"## Resource: `google_pubsub_topic`\n\n" +
+		"### Field `kms_key_name` changed from optional to required on `google_pubsub_topic`\n\n" +
+		upgradeGuidePlaceholder + "\n\n" +
+		"### Field `message_retention_duration` within resource `google_pubsub_topic` was either removed or renamed\n\n" +
+		"Removed in favor of `message_retention_period` in the 7.0.0 major release\n\n"
+	if diff := cmp.Diff(want, UpgradeGuide(breakingChanges)); diff != "" {
+		t.Errorf("UpgradeGuide() unexpected diff (-want, +got):\n%s", diff)
+	}
+}
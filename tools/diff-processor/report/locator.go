@@ -0,0 +1,199 @@
+package report
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+	"gopkg.in/yaml.v3"
+)
+
+// Location is a line of a source YAML file. Product YAML files are located by their
+// path in the Magic Modules repository, and metadata files by the path they were read from.
+type Location struct {
+	Path string
+	Line int
+}
+
+// Locator finds the source YAML lines that define resources and their fields. Fields of
+// MMv1 resources are found in the product YAML file they're generated from (if the
+// mmv1 directory is known), and other fields in the resource's metadata file.
+// Handwritten resources are located in the metadata file they're copied from in the
+// mmv1 directory, as the metadata files read are in the generated provider.
+type Locator struct {
+	mmv1Dir  string
+	metadata map[string]*metadata.Resource
+	products map[string]*yaml.Node
+}
+
+// NewLocator returns a Locator for the resources of schemaDiff, using their new
+// metadata (or old metadata, for removed resources). mmv1Dir is the mmv1 directory
+// of a Magic Modules checkout, or empty to only use metadata files.
+func NewLocator(mmv1Dir string, schemaDiff diff.SchemaDiff) *Locator {
+	l := &Locator{
+		mmv1Dir:  mmv1Dir,
+		metadata: make(map[string]*metadata.Resource),
+		products: make(map[string]*yaml.Node),
+	}
+	for name, resourceDiff := range schemaDiff {
+		if resourceDiff.ResourceConfig.NewMetadata != nil {
+			l.metadata[name] = resourceDiff.ResourceConfig.NewMetadata
+		} else if resourceDiff.ResourceConfig.OldMetadata != nil {
+			l.metadata[name] = resourceDiff.ResourceConfig.OldMetadata
+		}
+	}
+	return l
+}
+
+// Locate returns the location of a field of a resource, or of the resource itself if
+// field is empty. Fields that can't be found are located at the start of the resource's
+// file. It returns false for resources without metadata, and for handwritten resources
+// whose metadata file isn't found in the mmv1 directory.
+func (l *Locator) Locate(resource, field string) (Location, bool) {
+	m, ok := l.metadata[resource]
+	if !ok {
+		return Location{}, false
+	}
+	if m.GenerationType == "handwritten" {
+		return l.locateHandwritten(m, field)
+	}
+	if product := l.product(m.SourceFile); product != nil {
+		productPath := path.Join("mmv1", m.SourceFile)
+		if field == "" {
+			return Location{Path: productPath, Line: 1}, true
+		}
+		if line := propertyLine(product, field); line > 0 {
+			return Location{Path: productPath, Line: line}, true
+		}
+	}
+	if f := m.FieldsByName()[field]; f != nil && f.Line > 0 {
+		return Location{Path: m.Path, Line: f.Line}, true
+	}
+	return Location{Path: m.Path, Line: 1}, true
+}
+
+// locateHandwritten locates a field of a handwritten resource in the metadata file the
+// provider's copy at m.Path is made from, under third_party/terraform/services, which
+// may be a template.
+func (l *Locator) locateHandwritten(m *metadata.Resource, field string) (Location, bool) {
+	if l.mmv1Dir == "" {
+		return Location{}, false
+	}
+	dir, file := filepath.Split(m.Path)
+	service := filepath.Base(dir)
+	for _, name := range []string{file, file + ".tmpl"} {
+		sourceFile := path.Join("third_party/terraform/services", service, name)
+		b, err := os.ReadFile(filepath.Join(l.mmv1Dir, sourceFile))
+		if err != nil {
+			continue
+		}
+		location := Location{Path: path.Join("mmv1", sourceFile), Line: 1}
+		if line := metadataFieldLine(string(b), field); line > 0 {
+			location.Line = line
+		}
+		return location, true
+	}
+	return Location{}, false
+}
+
+// metadataFieldLine returns the line of a field in the contents of a metadata file, or
+// 0 if it isn't found. Metadata templates aren't valid YAML, so lines are matched
+// rather than parsed.
+func metadataFieldLine(contents, field string) int {
+	if field == "" {
+		return 0
+	}
+	for i, line := range strings.Split(contents, "\n") {
+		if m := metadataFieldRegexp.FindStringSubmatch(line); m != nil && m[1] == field {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// product returns the parsed product YAML file at sourceFile, or nil if it can't be read.
+func (l *Locator) product(sourceFile string) *yaml.Node {
+	if l.mmv1Dir == "" || sourceFile == "" {
+		return nil
+	}
+	if node, ok := l.products[sourceFile]; ok {
+		return node
+	}
+	var node *yaml.Node
+	if b, err := os.ReadFile(filepath.Join(l.mmv1Dir, sourceFile)); err == nil {
+		var doc yaml.Node
+		if err := yaml.Unmarshal(b, &doc); err == nil && len(doc.Content) > 0 {
+			node = doc.Content[0]
+		}
+	}
+	l.products[sourceFile] = node
+	return node
+}
+
+// propertyLine returns the line of the property of a product YAML file that generates
+// a (flattened) field, or 0 if it isn't found. Nested properties are found under
+// `properties`, or `item_type.properties` for arrays, the same way field names are
+// built for metadata files.
+func propertyLine(product *yaml.Node, field string) int {
+	var properties []*yaml.Node
+	for _, key := range []string{"parameters", "properties", "virtual_fields"} {
+		if list := mappingValue(product, key); list != nil {
+			properties = append(properties, list.Content...)
+		}
+	}
+	line := 0
+	for _, segment := range strings.Split(field, ".") {
+		var property *yaml.Node
+		for _, p := range properties {
+			if name := mappingValue(p, "name"); name != nil && underscore(name.Value) == segment {
+				property = p
+				break
+			}
+		}
+		if property == nil {
+			return 0
+		}
+		line = property.Line
+		properties = nil
+		nested := mappingValue(property, "properties")
+		if nested == nil {
+			nested = mappingValue(mappingValue(property, "item_type"), "properties")
+		}
+		if nested != nil {
+			properties = nested.Content
+		}
+	}
+	return line
+}
+
+// mappingValue returns the value of key in a yaml mapping node, or nil if it's missing.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+var (
+	acronymRegexp       = regexp.MustCompile(`([A-Z]+)([A-Z][a-z])`)
+	camelCaseRegexp     = regexp.MustCompile(`([a-z\d])([A-Z])`)
+	metadataFieldRegexp = regexp.MustCompile(`^\s*- field: ['"]?([\w.]+)['"]?\s*$`)
+)
+
+// underscore converts a property name to its Terraform field name, like MMv1 does.
+func underscore(name string) string {
+	name = acronymRegexp.ReplaceAllString(name, "${1}_${2}")
+	name = camelCaseRegexp.ReplaceAllString(name, "${1}_${2}")
+	name = strings.Replace(name, "-", "_", 1)
+	name = strings.Replace(name, ".", "_", 1)
+	return strings.ToLower(name)
+}
@@ -0,0 +1,134 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+)
+
+const (
+	topicMetadataPath     = "../testdata/google/services/pubsub/resource_pubsub_topic_generated_meta.yaml"
+	topicProductPath      = "mmv1/products/pubsub/Topic.yaml"
+	reservationSourcePath = "mmv1/third_party/terraform/services/pubsub/resource_pubsub_lite_reservation_meta.yaml.tmpl"
+)
+
+func newTestLocator(t *testing.T, mmv1Dir string) *Locator {
+	resourceMetadata, err := metadata.ReadDir("../testdata/google/services")
+	if err != nil {
+		t.Fatalf("error reading metadata: %v", err)
+	}
+	return NewLocator(mmv1Dir, diff.SchemaDiff{
+		"google_pubsub_topic": {
+			ResourceConfig: diff.ResourceConfigDiff{NewMetadata: resourceMetadata["google_pubsub_topic"]},
+		},
+		"google_pubsub_lite_reservation": {
+			ResourceConfig: diff.ResourceConfigDiff{OldMetadata: resourceMetadata["google_pubsub_lite_reservation"]},
+		},
+	})
+}
+
+func TestLocate(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		mmv1Dir  string
+		resource string
+		field    string
+		want     Location
+		wantOK   bool
+	}{
+		{
+			name:     "resource",
+			mmv1Dir:  "../testdata/mmv1",
+			resource: "google_pubsub_topic",
+			want:     Location{Path: topicProductPath, Line: 1},
+			wantOK:   true,
+		},
+		{
+			name:     "top-level property",
+			mmv1Dir:  "../testdata/mmv1",
+			resource: "google_pubsub_topic",
+			field:    "kms_key_name",
+			want:     Location{Path: topicProductPath, Line: 9},
+			wantOK:   true,
+		},
+		{
+			name:     "nested property",
+			mmv1Dir:  "../testdata/mmv1",
+			resource: "google_pubsub_topic",
+			field:    "ingestion_data_source_settings.cloud_storage.bucket",
+			want:     Location{Path: topicProductPath, Line: 24},
+			wantOK:   true,
+		},
+		{
+			name:     "property of array items",
+			mmv1Dir:  "../testdata/mmv1",
+			resource: "google_pubsub_topic",
+			field:    "schema_settings.schema",
+			want:     Location{Path: topicProductPath, Line: 31},
+			wantOK:   true,
+		},
+		{
+			name:     "field only in metadata",
+			mmv1Dir:  "../testdata/mmv1",
+			resource: "google_pubsub_topic",
+			field:    "effective_labels",
+			want:     Location{Path: topicMetadataPath, Line: 13},
+			wantOK:   true,
+		},
+		{
+			name:     "unknown field",
+			mmv1Dir:  "../testdata/mmv1",
+			resource: "google_pubsub_topic",
+			field:    "unknown_field",
+			want:     Location{Path: topicMetadataPath, Line: 1},
+			wantOK:   true,
+		},
+		{
+			name:     "without mmv1 dir",
+			resource: "google_pubsub_topic",
+			field:    "name",
+			want:     Location{Path: topicMetadataPath, Line: 15},
+			wantOK:   true,
+		},
+		{
+			name:     "handwritten resource",
+			mmv1Dir:  "../testdata/mmv1",
+			resource: "google_pubsub_lite_reservation",
+			want:     Location{Path: reservationSourcePath, Line: 1},
+			wantOK:   true,
+		},
+		{
+			name:     "handwritten resource field",
+			mmv1Dir:  "../testdata/mmv1",
+			resource: "google_pubsub_lite_reservation",
+			field:    "throughput_capacity",
+			want:     Location{Path: reservationSourcePath, Line: 12},
+			wantOK:   true,
+		},
+		{
+			name:     "handwritten resource unknown field",
+			mmv1Dir:  "../testdata/mmv1",
+			resource: "google_pubsub_lite_reservation",
+			field:    "unknown_field",
+			want:     Location{Path: reservationSourcePath, Line: 1},
+			wantOK:   true,
+		},
+		{
+			name:     "handwritten resource without mmv1 dir",
+			resource: "google_pubsub_lite_reservation",
+			field:    "throughput_capacity",
+		},
+		{
+			name:     "resource without metadata",
+			mmv1Dir:  "../testdata/mmv1",
+			resource: "google_pubsub_schema",
+			field:    "name",
+		},
+	} {
+		got, ok := newTestLocator(t, test.mmv1Dir).Locate(test.resource, test.field)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("test %s: Locate(%q, %q) = %v, %v; want %v, %v", test.name, test.resource, test.field, got, ok, test.want, test.wantOK)
+		}
+	}
+}
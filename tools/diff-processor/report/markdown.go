@@ -0,0 +1,57 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenderMarkdown renders the report for a pull request comment: a section per changed
+// object, with a table of the attribute changes of each field of modified objects,
+// followed by a table of the breaking changes.
+func RenderMarkdown(report Report) string {
+	if len(report.Resources) == 0 && len(report.BreakingChanges) == 0 {
+		return "No schema changes.\n"
+	}
+	var sb strings.Builder
+	if len(report.Resources) > 0 {
+		sb.WriteString("## Schema changes\n")
+	}
+	for _, resource := range report.Resources {
+		heading := resource.Kind
+		if resource.Name != "" {
+			heading = fmt.Sprintf("%s: `%s`", resource.Kind, resource.Name)
+		}
+		fmt.Fprintf(&sb, "\n### %s (%s)\n", heading, resource.Change)
+		if resource.Change != ChangeModified || len(resource.Fields) == 0 {
+			continue
+		}
+		sb.WriteString("\n| Field | Change | Attribute | Before | After |\n| --- | --- | --- | --- | --- |\n")
+		for _, field := range resource.Fields {
+			if len(field.Attributes) == 0 {
+				fmt.Fprintf(&sb, "| `%s` | %s | - | - | - |\n", field.Field, field.Change)
+			}
+			for _, attribute := range field.Attributes {
+				fmt.Fprintf(&sb, "| `%s` | %s | %s | %s | %s |\n", field.Field, field.Change, attribute.Attribute, markdownCell(attribute.Old), markdownCell(attribute.New))
+			}
+		}
+	}
+	if len(report.BreakingChanges) > 0 {
+		if len(report.Resources) > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("## Breaking changes\n\n| Severity | Change | Rule |\n| --- | --- | --- |\n")
+		for _, breakingChange := range report.BreakingChanges {
+			fmt.Fprintf(&sb, "| %s | %s | [%s](%s) |\n", breakingChange.Severity, markdownCell(breakingChange.Message), breakingChange.RuleName, breakingChange.DocumentationReference)
+		}
+	}
+	return sb.String()
+}
+
+// markdownCell escapes a value for a markdown table cell, using `-` for empty values.
+func markdownCell(value string) string {
+	if value == "" {
+		return "-"
+	}
+	value = strings.ReplaceAll(value, "|", `\|`)
+	return strings.ReplaceAll(value, "\n", " ")
+}
@@ -0,0 +1,199 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/breaking_changes"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Change is how an object or field changed between the old and new provider.
+type Change string
+
+const (
+	ChangeAdded    Change = "added"
+	ChangeRemoved  Change = "removed"
+	ChangeModified Change = "modified"
+)
+
+// Kinds of the objects in a Report.
+const (
+	KindResource          = "Resource"
+	KindDataSource        = "Data source"
+	KindEphemeralResource = "Ephemeral resource"
+	KindProvider          = "Provider"
+)
+
+// Report is the detailed schema diff between two provider versions.
+type Report struct {
+	Resources       []ResourceReport
+	BreakingChanges []breaking_changes.BreakingChange
+}
+
+// ResourceReport holds the changes to a resource (or data source, ephemeral resource,
+// or the provider configuration, which has no name).
+type ResourceReport struct {
+	Kind   string
+	Name   string
+	Change Change
+	Fields []FieldReport
+}
+
+// FieldReport holds the changes to a single (flattened) field.
+type FieldReport struct {
+	Field      string
+	Change     Change
+	Attributes []AttributeChange
+}
+
+// AttributeChange is the value of a field attribute before and after the change. An
+// empty value means that the attribute is unset (or the field doesn't exist).
+type AttributeChange struct {
+	Attribute string
+	Old       string
+	New       string
+}
+
+// ComputeReport details the changes of every field in the provider schema diff, along
+// with the breaking changes found in it. Modified fields are only reported if one of
+// their reported attributes changed.
+func ComputeReport(schemaDiff diff.ProviderSchemaDiff, breakingChanges []breaking_changes.BreakingChange) Report {
+	var resources []ResourceReport
+	resources = append(resources, computeResourceReports(KindResource, schemaDiff.Resources)...)
+	resources = append(resources, computeResourceReports(KindDataSource, schemaDiff.DataSources)...)
+	resources = append(resources, computeResourceReports(KindEphemeralResource, schemaDiff.EphemeralResources)...)
+	if fields := computeFieldReports(schemaDiff.Provider.Fields); len(fields) > 0 {
+		resources = append(resources, ResourceReport{Kind: KindProvider, Change: ChangeModified, Fields: fields})
+	}
+
+	sorted := append([]breaking_changes.BreakingChange(nil), breakingChanges...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Message < sorted[j].Message
+	})
+	return Report{Resources: resources, BreakingChanges: sorted}
+}
+
+func computeResourceReports(kind string, schemaDiff diff.SchemaDiff) []ResourceReport {
+	var resources []ResourceReport
+	for name, resourceDiff := range schemaDiff {
+		change := ChangeModified
+		if resourceDiff.ResourceConfig.Old == nil {
+			change = ChangeAdded
+		} else if resourceDiff.ResourceConfig.New == nil {
+			change = ChangeRemoved
+		}
+		resources = append(resources, ResourceReport{
+			Kind:   kind,
+			Name:   name,
+			Change: change,
+			Fields: computeFieldReports(resourceDiff.Fields),
+		})
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Name < resources[j].Name
+	})
+	return resources
+}
+
+func computeFieldReports(fieldDiffs map[string]diff.FieldDiff) []FieldReport {
+	var fields []FieldReport
+	for field, fieldDiff := range fieldDiffs {
+		change := ChangeModified
+		if fieldDiff.Old == nil {
+			change = ChangeAdded
+		} else if fieldDiff.New == nil {
+			change = ChangeRemoved
+		}
+		attributes := attributeChanges(fieldDiff)
+		if change == ChangeModified && len(attributes) == 0 {
+			continue
+		}
+		fields = append(fields, FieldReport{Field: field, Change: change, Attributes: attributes})
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Field < fields[j].Field
+	})
+	return fields
+}
+
+// attributeChanges returns the reported attributes whose value differs between the
+// old and new field, in a fixed order.
+func attributeChanges(fieldDiff diff.FieldDiff) []AttributeChange {
+	oldAttributes := attributes(fieldDiff.Old, fieldDiff.OldMetadata)
+	newAttributes := attributes(fieldDiff.New, fieldDiff.NewMetadata)
+	var changes []AttributeChange
+	for i := range oldAttributes {
+		if oldAttributes[i].value != newAttributes[i].value {
+			changes = append(changes, AttributeChange{
+				Attribute: oldAttributes[i].name,
+				Old:       oldAttributes[i].value,
+				New:       newAttributes[i].value,
+			})
+		}
+	}
+	return changes
+}
+
+type attribute struct {
+	name  string
+	value string
+}
+
+// attributes returns the reported attributes of a field, with an empty value for
+// unset attributes (so a missing field has no attributes set). Constraints only come
+// from the field's metadata.
+func attributes(s *schema.Schema, m *metadata.Field) []attribute {
+	if s == nil {
+		s = &schema.Schema{}
+	}
+	var constraints metadata.Constraints
+	if m != nil {
+		constraints = m.Constraints
+	}
+	return []attribute{
+		{name: "Required", value: formatBool(s.Required)},
+		{name: "Optional", value: formatBool(s.Optional)},
+		{name: "Computed", value: formatBool(s.Computed)},
+		{name: "Type", value: formatType(s.Type)},
+		{name: "Default", value: formatDefault(s.Default)},
+		{name: "ForceNew", value: formatBool(s.ForceNew)},
+		{name: "MaxItems", value: formatInt(s.MaxItems)},
+		{name: "EnumValues", value: strings.Join(constraints.EnumValues, ", ")},
+		{name: "ValidationRegex", value: constraints.ValidationRegex},
+		{name: "Min", value: constraints.Min},
+		{name: "Max", value: constraints.Max},
+	}
+}
+
+func formatBool(b bool) string {
+	if !b {
+		return ""
+	}
+	return strconv.FormatBool(b)
+}
+
+func formatType(t schema.ValueType) string {
+	if t == schema.TypeInvalid {
+		return ""
+	}
+	return strings.TrimPrefix(t.String(), "Type")
+}
+
+func formatInt(i int) string {
+	if i == 0 {
+		return ""
+	}
+	return strconv.Itoa(i)
+}
+
+func formatDefault(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
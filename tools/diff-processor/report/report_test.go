@@ -0,0 +1,214 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/breaking_changes"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var testSchemaDiff = diff.ProviderSchemaDiff{
+	Resources: diff.SchemaDiff{
+		"google_pubsub_topic": {
+			ResourceConfig: diff.ResourceConfigDiff{Old: &schema.Resource{}, New: &schema.Resource{}},
+			Fields: map[string]diff.FieldDiff{
+				"kms_key_name": {
+					New: &schema.Schema{Type: schema.TypeString, Optional: true, ForceNew: true},
+				},
+				"name": {
+					Old:         &schema.Schema{Type: schema.TypeString, Required: true},
+					New:         &schema.Schema{Type: schema.TypeString, Required: true},
+					OldMetadata: &metadata.Field{Field: "name", Constraints: metadata.Constraints{ValidationRegex: "^[a-z]+$"}},
+					NewMetadata: &metadata.Field{Field: "name", Constraints: metadata.Constraints{ValidationRegex: "^[a-z|]+$"}},
+				},
+				"message_storage_policy": {
+					Old: &schema.Schema{Type: schema.TypeList, Optional: true},
+					New: &schema.Schema{Type: schema.TypeList, Optional: true, MaxItems: 1},
+				},
+				"labels": {
+					Old: &schema.Schema{Type: schema.TypeMap, Optional: true},
+					New: &schema.Schema{Type: schema.TypeMap, Optional: true, Description: "Labels"},
+				},
+				"message_retention_duration": {
+					Old: &schema.Schema{Type: schema.TypeString, Optional: true, Default: "604800s"},
+				},
+			},
+		},
+		"google_pubsub_schema": {
+			ResourceConfig: diff.ResourceConfigDiff{New: &schema.Resource{}},
+			Fields: map[string]diff.FieldDiff{
+				"name": {New: &schema.Schema{Type: schema.TypeString, Required: true}},
+			},
+		},
+	},
+	DataSources: diff.SchemaDiff{
+		"google_pubsub_topic": {
+			ResourceConfig: diff.ResourceConfigDiff{Old: &schema.Resource{}, New: &schema.Resource{}},
+			Fields: map[string]diff.FieldDiff{
+				"kms_key_name": {New: &schema.Schema{Type: schema.TypeString, Computed: true}},
+			},
+		},
+	},
+	Provider: diff.ResourceDiff{
+		Fields: map[string]diff.FieldDiff{
+			"project": {
+				Old: &schema.Schema{Type: schema.TypeString, Optional: true},
+				New: &schema.Schema{Type: schema.TypeString, Required: true},
+			},
+		},
+	},
+}
+
+var testBreakingChanges = []breaking_changes.BreakingChange{
+	{
+		Resource:               "google_pubsub_topic",
+		Field:                  "message_retention_duration",
+		Message:                "Field `message_retention_duration` within resource `google_pubsub_topic` was either removed or renamed",
+		DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#resource-schema-field-removal-or-rename",
+		RuleName:               "resource-schema-field-removal-or-rename",
+		Severity:               breaking_changes.SeverityBlocking,
+	},
+	{
+		Resource:               "google_pubsub_topic",
+		Field:                  "name",
+		Message:                "Field `name` within resource `google_pubsub_topic` has a narrower validation",
+		DocumentationReference: "https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#field-narrowing-validation",
+		RuleName:               "field-narrowing-validation",
+		Severity:               breaking_changes.SeverityAcknowledged,
+	},
+}
+
+func TestComputeReport(t *testing.T) {
+	want := Report{
+		Resources: []ResourceReport{
+			{
+				Kind:   KindResource,
+				Name:   "google_pubsub_schema",
+				Change: ChangeAdded,
+				Fields: []FieldReport{
+					{
+						Field:  "name",
+						Change: ChangeAdded,
+						Attributes: []AttributeChange{
+							{Attribute: "Required", New: "true"},
+							{Attribute: "Type", New: "String"},
+						},
+					},
+				},
+			},
+			{
+				Kind:   KindResource,
+				Name:   "google_pubsub_topic",
+				Change: ChangeModified,
+				Fields: []FieldReport{
+					{
+						Field:  "kms_key_name",
+						Change: ChangeAdded,
+						Attributes: []AttributeChange{
+							{Attribute: "Optional", New: "true"},
+							{Attribute: "Type", New: "String"},
+							{Attribute: "ForceNew", New: "true"},
+						},
+					},
+					{
+						Field:  "message_retention_duration",
+						Change: ChangeRemoved,
+						Attributes: []AttributeChange{
+							{Attribute: "Optional", Old: "true"},
+							{Attribute: "Type", Old: "String"},
+							{Attribute: "Default", Old: "604800s"},
+						},
+					},
+					{
+						Field:      "message_storage_policy",
+						Change:     ChangeModified,
+						Attributes: []AttributeChange{{Attribute: "MaxItems", New: "1"}},
+					},
+					{
+						Field:      "name",
+						Change:     ChangeModified,
+						Attributes: []AttributeChange{{Attribute: "ValidationRegex", Old: "^[a-z]+$", New: "^[a-z|]+$"}},
+					},
+				},
+			},
+			{
+				Kind:   KindDataSource,
+				Name:   "google_pubsub_topic",
+				Change: ChangeModified,
+				Fields: []FieldReport{
+					{
+						Field:  "kms_key_name",
+						Change: ChangeAdded,
+						Attributes: []AttributeChange{
+							{Attribute: "Computed", New: "true"},
+							{Attribute: "Type", New: "String"},
+						},
+					},
+				},
+			},
+			{
+				Kind:   KindProvider,
+				Change: ChangeModified,
+				Fields: []FieldReport{
+					{
+						Field:  "project",
+						Change: ChangeModified,
+						Attributes: []AttributeChange{
+							{Attribute: "Required", New: "true"},
+							{Attribute: "Optional", Old: "true"},
+						},
+					},
+				},
+			},
+		},
+		BreakingChanges: []breaking_changes.BreakingChange{testBreakingChanges[0], testBreakingChanges[1]},
+	}
+	if diff := cmp.Diff(want, ComputeReport(testSchemaDiff, testBreakingChanges)); diff != "" {
+		t.Errorf("ComputeReport() unexpected diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	cases := map[string]struct {
+		report Report
+		want   string
+	}{
+		"no changes": {
+			want: "No schema changes.\n",
+		},
+		"changes": {
+			report: ComputeReport(testSchemaDiff, testBreakingChanges),
+			want: "## Schema changes\n" +
+				"\n### Resource: `google_pubsub_schema` (added)\n" +
+				"\n### Resource: `google_pubsub_topic` (modified)\n" +
+				"\n| Field | Change | Attribute | Before | After |\n| --- | --- | --- | --- | --- |\n" +
+				"| `kms_key_name` | added | Optional | - | true |\n" +
+				"| `kms_key_name` | added | Type | - | String |\n" +
+				"| `kms_key_name` | added | ForceNew | - | true |\n" +
+				"| `message_retention_duration` | removed | Optional | true | - |\n" +
+				"| `message_retention_duration` | removed | Type | String | - |\n" +
+				"| `message_retention_duration` | removed | Default | 604800s | - |\n" +
+				"| `message_storage_policy` | modified | MaxItems | - | 1 |\n" +
+				"| `name` | modified | ValidationRegex | ^[a-z]+$ | ^[a-z\\|]+$ |\n" +
+				"\n### Data source: `google_pubsub_topic` (modified)\n" +
+				"\n| Field | Change | Attribute | Before | After |\n| --- | --- | --- | --- | --- |\n" +
+				"| `kms_key_name` | added | Computed | - | true |\n" +
+				"| `kms_key_name` | added | Type | - | String |\n" +
+				"\n### Provider (modified)\n" +
+				"\n| Field | Change | Attribute | Before | After |\n| --- | --- | --- | --- | --- |\n" +
+				"| `project` | modified | Required | - | true |\n" +
+				"| `project` | modified | Optional | true | - |\n" +
+				"\n## Breaking changes\n\n| Severity | Change | Rule |\n| --- | --- | --- |\n" +
+				"| blocking | Field `message_retention_duration` within resource `google_pubsub_topic` was either removed or renamed | [resource-schema-field-removal-or-rename](https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#resource-schema-field-removal-or-rename) |\n" +
+				"| acknowledged | Field `name` within resource `google_pubsub_topic` has a narrower validation | [field-narrowing-validation](https://googlecloudplatform.github.io/magic-modules/breaking-changes/breaking-changes#field-narrowing-validation) |\n",
+		},
+	}
+	for tn, tc := range cases {
+		if diff := cmp.Diff(tc.want, RenderMarkdown(tc.report)); diff != "" {
+			t.Errorf("%s: RenderMarkdown() unexpected diff (-want, +got):\n%s", tn, diff)
+		}
+	}
+}
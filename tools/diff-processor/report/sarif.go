@@ -0,0 +1,186 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/breaking_changes"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	toolName     = "diff-processor"
+	toolURI      = "https://github.com/GoogleCloudPlatform/magic-modules/tree/main/tools/diff-processor"
+
+	// fieldChangeRule is the rule of the results reporting field changes.
+	fieldChangeRule = "schema-field-change"
+)
+
+// SARIF is a SARIF 2.1.0 log, limited to the properties used by code scanning.
+type SARIF struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+type SARIFRun struct {
+	Tool    SARIFTool     `json:"tool"`
+	Results []SARIFResult `json:"results"`
+}
+
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+type SARIFDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []SARIFRule `json:"rules"`
+}
+
+type SARIFRule struct {
+	ID               string       `json:"id"`
+	ShortDescription SARIFMessage `json:"shortDescription"`
+	HelpURI          string       `json:"helpUri,omitempty"`
+}
+
+type SARIFResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   SARIFMessage    `json:"message"`
+	Locations []SARIFLocation `json:"locations,omitempty"`
+}
+
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+type SARIFLocation struct {
+	PhysicalLocation SARIFPhysicalLocation `json:"physicalLocation"`
+}
+
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+	Region           SARIFRegion           `json:"region"`
+}
+
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type SARIFRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// NewSARIF converts the report to SARIF for code scanning annotations. Breaking changes
+// are reported with their rule, as errors (blocking), warnings (informational) or notes
+// (acknowledged). Field changes of modified resources are reported as notes. Results are located
+// with locator; objects it can't locate (like the provider configuration) have no location.
+func NewSARIF(report Report, locator *Locator) SARIF {
+	rules := map[string]SARIFRule{
+		fieldChangeRule: {
+			ID:               fieldChangeRule,
+			ShortDescription: SARIFMessage{Text: "A field of a resource was added, removed or modified"},
+		},
+	}
+	results := []SARIFResult{}
+	for _, breakingChange := range report.BreakingChanges {
+		rules[breakingChange.RuleName] = SARIFRule{
+			ID:               breakingChange.RuleName,
+			ShortDescription: SARIFMessage{Text: breakingChange.RuleName},
+			HelpURI:          breakingChange.DocumentationReference,
+		}
+		results = append(results, SARIFResult{
+			RuleID:    breakingChange.RuleName,
+			Level:     sarifLevel(breakingChange.Severity),
+			Message:   SARIFMessage{Text: breakingChange.Message},
+			Locations: breakingChangeLocations(locator, breakingChange),
+		})
+	}
+	for _, resource := range report.Resources {
+		if resource.Kind != KindResource || resource.Change != ChangeModified {
+			continue
+		}
+		for _, field := range resource.Fields {
+			results = append(results, SARIFResult{
+				RuleID:    fieldChangeRule,
+				Level:     "note",
+				Message:   SARIFMessage{Text: fieldChangeMessage(resource.Name, field)},
+				Locations: sarifLocations(locator, resource.Name, field.Field),
+			})
+		}
+	}
+
+	ids := make([]string, 0, len(rules))
+	for id := range rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	driver := SARIFDriver{Name: toolName, InformationURI: toolURI}
+	for _, id := range ids {
+		driver.Rules = append(driver.Rules, rules[id])
+	}
+	return SARIF{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    []SARIFRun{{Tool: SARIFTool{Driver: driver}, Results: results}},
+	}
+}
+
+func sarifLevel(severity breaking_changes.Severity) string {
+	switch severity {
+	case breaking_changes.SeverityInformational:
+		return "warning"
+	case breaking_changes.SeverityAcknowledged:
+		return "note"
+	}
+	return "error"
+}
+
+// breakingChangeLocations locates a breaking change. Data sources have no source files,
+// so their changes aren't located.
+func breakingChangeLocations(locator *Locator, breakingChange breaking_changes.BreakingChange) []SARIFLocation {
+	if strings.HasPrefix(breakingChange.RuleName, "data-source-") {
+		return nil
+	}
+	return sarifLocations(locator, breakingChange.Resource, breakingChange.Field)
+}
+
+func sarifLocations(locator *Locator, resource, field string) []SARIFLocation {
+	if locator == nil {
+		return nil
+	}
+	location, ok := locator.Locate(resource, field)
+	if !ok {
+		return nil
+	}
+	return []SARIFLocation{{
+		PhysicalLocation: SARIFPhysicalLocation{
+			ArtifactLocation: SARIFArtifactLocation{URI: location.Path},
+			Region:           SARIFRegion{StartLine: location.Line},
+		},
+	}}
+}
+
+// fieldChangeMessage describes a field change, e.g.
+// "Field `name` of resource `google_x` was modified: Required: unset -> true".
+func fieldChangeMessage(resource string, field FieldReport) string {
+	message := fmt.Sprintf("Field `%s` of resource `%s` was %s", field.Field, resource, field.Change)
+	if field.Change != ChangeModified {
+		return message
+	}
+	var changes []string
+	for _, attribute := range field.Attributes {
+		changes = append(changes, fmt.Sprintf("%s: %s -> %s", attribute.Attribute, sarifValue(attribute.Old), sarifValue(attribute.New)))
+	}
+	return message + ": " + strings.Join(changes, ", ")
+}
+
+func sarifValue(value string) string {
+	if value == "" {
+		return "unset"
+	}
+	return value
+}
@@ -0,0 +1,87 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func sarifLocation(path string, line int) []SARIFLocation {
+	return []SARIFLocation{{
+		PhysicalLocation: SARIFPhysicalLocation{
+			ArtifactLocation: SARIFArtifactLocation{URI: path},
+			Region:           SARIFRegion{StartLine: line},
+		},
+	}}
+}
+
+func TestNewSARIF(t *testing.T) {
+	got := NewSARIF(ComputeReport(testSchemaDiff, testBreakingChanges), newTestLocator(t, "../testdata/mmv1"))
+	want := SARIF{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []SARIFRun{{
+			Tool: SARIFTool{Driver: SARIFDriver{
+				Name:           toolName,
+				InformationURI: toolURI,
+				Rules: []SARIFRule{
+					{
+						ID:               "field-narrowing-validation",
+						ShortDescription: SARIFMessage{Text: "field-narrowing-validation"},
+						HelpURI:          testBreakingChanges[1].DocumentationReference,
+					},
+					{
+						ID:               "resource-schema-field-removal-or-rename",
+						ShortDescription: SARIFMessage{Text: "resource-schema-field-removal-or-rename"},
+						HelpURI:          testBreakingChanges[0].DocumentationReference,
+					},
+					{
+						ID:               fieldChangeRule,
+						ShortDescription: SARIFMessage{Text: "A field of a resource was added, removed or modified"},
+					},
+				},
+			}},
+			Results: []SARIFResult{
+				{
+					RuleID:    "resource-schema-field-removal-or-rename",
+					Level:     "error",
+					Message:   SARIFMessage{Text: testBreakingChanges[0].Message},
+					Locations: sarifLocation(topicMetadataPath, 1),
+				},
+				{
+					RuleID:    "field-narrowing-validation",
+					Level:     "note",
+					Message:   SARIFMessage{Text: testBreakingChanges[1].Message},
+					Locations: sarifLocation(topicProductPath, 6),
+				},
+				{
+					RuleID:    fieldChangeRule,
+					Level:     "note",
+					Message:   SARIFMessage{Text: "Field `kms_key_name` of resource `google_pubsub_topic` was added"},
+					Locations: sarifLocation(topicProductPath, 9),
+				},
+				{
+					RuleID:    fieldChangeRule,
+					Level:     "note",
+					Message:   SARIFMessage{Text: "Field `message_retention_duration` of resource `google_pubsub_topic` was removed"},
+					Locations: sarifLocation(topicMetadataPath, 1),
+				},
+				{
+					RuleID:    fieldChangeRule,
+					Level:     "note",
+					Message:   SARIFMessage{Text: "Field `message_storage_policy` of resource `google_pubsub_topic` was modified: MaxItems: unset -> 1"},
+					Locations: sarifLocation(topicProductPath, 11),
+				},
+				{
+					RuleID:    fieldChangeRule,
+					Level:     "note",
+					Message:   SARIFMessage{Text: "Field `name` of resource `google_pubsub_topic` was modified: ValidationRegex: ^[a-z]+$ -> ^[a-z|]+$"},
+					Locations: sarifLocation(topicProductPath, 6),
+				},
+			},
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewSARIF() unexpected diff (-want, +got):\n%s", diff)
+	}
+}
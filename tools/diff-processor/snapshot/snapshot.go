@@ -0,0 +1,557 @@
+// Package snapshot serializes provider schemas, so that provider builds can be diffed
+// from stored artifacts instead of being compiled into diff-processor.
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+)
+
+// FormatVersion is the version of the snapshot format written by Write.
+const FormatVersion = "1"
+
+// Snapshot is the serialized schema of a provider build. Unlike the output of
+// `terraform providers schema -json`, it keeps the SDKv2 details diffs rely on
+// (ForceNew, defaults, field sets, whether functions like validation are set...)
+// and the metadata of the provider's resources.
+type Snapshot struct {
+	FormatVersion      string                        `json:"format_version"`
+	Provider           *Resource                     `json:"provider,omitempty"`
+	Resources          map[string]*Resource          `json:"resources"`
+	DataSources        map[string]*Resource          `json:"data_sources"`
+	EphemeralResources map[string]*Resource          `json:"ephemeral_resources,omitempty"`
+	Functions          map[string]*Function          `json:"functions,omitempty"`
+	ResourceMetadata   map[string]*metadata.Resource `json:"resource_metadata,omitempty"`
+	// ProtocolResources lists the resources converted from the protocol schema.
+	ProtocolResources []string `json:"protocol_resources,omitempty"`
+	// Source is set for snapshots of schemas read from `terraform providers schema -json`,
+	// so that they're not diffed against a schema with more details.
+	Source diff.SchemaSource `json:"source,omitempty"`
+}
+
+// Resource is the serialized schema of a resource, data source, ephemeral resource,
+// nested block or provider configuration.
+type Resource struct {
+	Description           string            `json:"description,omitempty"`
+	DeprecationMessage    string            `json:"deprecation_message,omitempty"`
+	SchemaVersion         int               `json:"schema_version,omitempty"`
+	StateUpgraderVersions []int             `json:"state_upgrader_versions,omitempty"`
+	Importer              bool              `json:"importer,omitempty"`
+	Timeouts              *Timeouts         `json:"timeouts,omitempty"`
+	Schema                map[string]*Field `json:"schema"`
+}
+
+// Timeouts are the default timeouts of a resource, as durations like "20m0s".
+type Timeouts struct {
+	Create  string `json:"create,omitempty"`
+	Read    string `json:"read,omitempty"`
+	Update  string `json:"update,omitempty"`
+	Delete  string `json:"delete,omitempty"`
+	Default string `json:"default,omitempty"`
+}
+
+// Field is the serialized schema of a field. Functions can't be serialized, so only
+// whether they're set is recorded, which is all diffs compare.
+type Field struct {
+	Type                  string      `json:"type"`
+	ConfigMode            string      `json:"config_mode,omitempty"`
+	Required              bool        `json:"required,omitempty"`
+	Optional              bool        `json:"optional,omitempty"`
+	Computed              bool        `json:"computed,omitempty"`
+	ForceNew              bool        `json:"force_new,omitempty"`
+	DiffSuppressOnRefresh bool        `json:"diff_suppress_on_refresh,omitempty"`
+	Default               interface{} `json:"default,omitempty"`
+	Description           string      `json:"description,omitempty"`
+	InputDefault          string      `json:"input_default,omitempty"`
+	MaxItems              int         `json:"max_items,omitempty"`
+	MinItems              int         `json:"min_items,omitempty"`
+	Deprecated            string      `json:"deprecated,omitempty"`
+	Sensitive             bool        `json:"sensitive,omitempty"`
+	ConflictsWith         []string    `json:"conflicts_with,omitempty"`
+	ExactlyOneOf          []string    `json:"exactly_one_of,omitempty"`
+	AtLeastOneOf          []string    `json:"at_least_one_of,omitempty"`
+	RequiredWith          []string    `json:"required_with,omitempty"`
+
+	// ElemField is set for collections of primitives, and ElemResource for nested blocks.
+	ElemField    *Field    `json:"elem_field,omitempty"`
+	ElemResource *Resource `json:"elem_resource,omitempty"`
+
+	HasDiffSuppressFunc bool `json:"has_diff_suppress_func,omitempty"`
+	HasDefaultFunc      bool `json:"has_default_func,omitempty"`
+	HasStateFunc        bool `json:"has_state_func,omitempty"`
+	HasSetFunc          bool `json:"has_set_func,omitempty"`
+	HasValidateFunc     bool `json:"has_validate_func,omitempty"`
+	HasValidateDiagFunc bool `json:"has_validate_diag_func,omitempty"`
+}
+
+// Function is the serialized signature of a provider function, in the same format
+// as `terraform providers schema -json`. Types are JSON type constraints like
+// "string" or ["list","string"].
+type Function struct {
+	Summary            string           `json:"summary,omitempty"`
+	Description        string           `json:"description,omitempty"`
+	DeprecationMessage string           `json:"deprecation_message,omitempty"`
+	ReturnType         json.RawMessage  `json:"return_type,omitempty"`
+	Parameters         []*FunctionParam `json:"parameters,omitempty"`
+	VariadicParameter  *FunctionParam   `json:"variadic_parameter,omitempty"`
+}
+
+// FunctionParam is the serialized signature of a parameter of a provider function.
+type FunctionParam struct {
+	Name               string          `json:"name"`
+	Description        string          `json:"description,omitempty"`
+	Type               json.RawMessage `json:"type,omitempty"`
+	IsNullable         bool            `json:"is_nullable,omitempty"`
+	AllowUnknownValues bool            `json:"allow_unknown_values,omitempty"`
+}
+
+// Write writes the snapshot of a provider schema to w.
+func Write(w io.Writer, providerSchema diff.ProviderSchema) error {
+	s, err := New(providerSchema)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
+
+// Read reads a provider schema from a file holding either a snapshot written by
+// Write or the output of `terraform providers schema -json`.
+func Read(path string) (diff.ProviderSchema, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return diff.ProviderSchema{}, err
+	}
+	providerSchema, err := Parse(b)
+	if err != nil {
+		return diff.ProviderSchema{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return providerSchema, nil
+}
+
+// Parse parses a provider schema from either a snapshot or the output of
+// `terraform providers schema -json`, which is detected by its provider_schemas key.
+func Parse(b []byte) (diff.ProviderSchema, error) {
+	var header struct {
+		FormatVersion   string          `json:"format_version"`
+		ProviderSchemas json.RawMessage `json:"provider_schemas"`
+	}
+	if err := json.Unmarshal(b, &header); err != nil {
+		return diff.ProviderSchema{}, fmt.Errorf("error parsing schema: %w", err)
+	}
+	if header.ProviderSchemas != nil {
+		return parseTerraformSchemas(header.ProviderSchemas)
+	}
+	if header.FormatVersion != FormatVersion {
+		return diff.ProviderSchema{}, fmt.Errorf("unsupported snapshot format version %q, expected %q", header.FormatVersion, FormatVersion)
+	}
+	var s Snapshot
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	// Numbers are decoded based on the field type, see defaultValue.
+	decoder.UseNumber()
+	if err := decoder.Decode(&s); err != nil {
+		return diff.ProviderSchema{}, fmt.Errorf("error parsing snapshot: %w", err)
+	}
+	return s.ProviderSchema()
+}
+
+// New returns the snapshot of a provider schema.
+func New(providerSchema diff.ProviderSchema) (*Snapshot, error) {
+	s := &Snapshot{
+		FormatVersion:      FormatVersion,
+		Provider:           newResource(providerSchema.Provider),
+		Resources:          newResources(providerSchema.Resources),
+		DataSources:        newResources(providerSchema.DataSources),
+		EphemeralResources: newResources(providerSchema.EphemeralResources),
+		ResourceMetadata:   providerSchema.ResourceMetadata,
+		Source:             providerSchema.Source,
+	}
+	for name := range providerSchema.ProtocolResources {
+		s.ProtocolResources = append(s.ProtocolResources, name)
+	}
+	sort.Strings(s.ProtocolResources)
+	if len(providerSchema.Functions) > 0 {
+		s.Functions = make(map[string]*Function, len(providerSchema.Functions))
+		for name, f := range providerSchema.Functions {
+			function, err := newFunction(f)
+			if err != nil {
+				return nil, fmt.Errorf("function %s: %w", name, err)
+			}
+			s.Functions[name] = function
+		}
+	}
+	return s, nil
+}
+
+// ProviderSchema returns the provider schema the snapshot was taken of.
+func (s *Snapshot) ProviderSchema() (diff.ProviderSchema, error) {
+	providerSchema := diff.ProviderSchema{
+		Provider:           s.Provider.resource(),
+		Resources:          resources(s.Resources),
+		DataSources:        resources(s.DataSources),
+		EphemeralResources: resources(s.EphemeralResources),
+		ResourceMetadata:   s.ResourceMetadata,
+		Source:             s.Source,
+	}
+	if len(s.ProtocolResources) > 0 {
+		providerSchema.ProtocolResources = make(map[string]bool, len(s.ProtocolResources))
+		for _, name := range s.ProtocolResources {
+			providerSchema.ProtocolResources[name] = true
+		}
+	}
+	if providerSchema.Provider == nil {
+		providerSchema.Provider = &schema.Resource{Schema: map[string]*schema.Schema{}}
+	}
+	if len(s.Functions) > 0 {
+		providerSchema.Functions = make(map[string]*tfprotov5.Function, len(s.Functions))
+		for name, f := range s.Functions {
+			function, err := f.function()
+			if err != nil {
+				return diff.ProviderSchema{}, fmt.Errorf("function %s: %w", name, err)
+			}
+			providerSchema.Functions[name] = function
+		}
+	}
+	return providerSchema, nil
+}
+
+func newResources(resources map[string]*schema.Resource) map[string]*Resource {
+	if resources == nil {
+		return nil
+	}
+	snapshots := make(map[string]*Resource, len(resources))
+	for name, r := range resources {
+		snapshots[name] = newResource(r)
+	}
+	return snapshots
+}
+
+func resources(snapshots map[string]*Resource) map[string]*schema.Resource {
+	if snapshots == nil {
+		return nil
+	}
+	resources := make(map[string]*schema.Resource, len(snapshots))
+	for name, r := range snapshots {
+		resources[name] = r.resource()
+	}
+	return resources
+}
+
+func newResource(r *schema.Resource) *Resource {
+	if r == nil {
+		return nil
+	}
+	snapshot := &Resource{
+		Description:        r.Description,
+		DeprecationMessage: r.DeprecationMessage,
+		SchemaVersion:      r.SchemaVersion,
+		Importer:           r.Importer != nil,
+		Schema:             make(map[string]*Field, len(r.Schema)),
+	}
+	for _, upgrader := range r.StateUpgraders {
+		snapshot.StateUpgraderVersions = append(snapshot.StateUpgraderVersions, upgrader.Version)
+	}
+	if r.Timeouts != nil {
+		snapshot.Timeouts = &Timeouts{
+			Create:  formatDuration(r.Timeouts.Create),
+			Read:    formatDuration(r.Timeouts.Read),
+			Update:  formatDuration(r.Timeouts.Update),
+			Delete:  formatDuration(r.Timeouts.Delete),
+			Default: formatDuration(r.Timeouts.Default),
+		}
+	}
+	for name, field := range r.Schema {
+		snapshot.Schema[name] = newField(field)
+	}
+	return snapshot
+}
+
+func (r *Resource) resource() *schema.Resource {
+	if r == nil {
+		return nil
+	}
+	resource := &schema.Resource{
+		Description:        r.Description,
+		DeprecationMessage: r.DeprecationMessage,
+		SchemaVersion:      r.SchemaVersion,
+		Schema:             make(map[string]*schema.Schema, len(r.Schema)),
+	}
+	for _, version := range r.StateUpgraderVersions {
+		resource.StateUpgraders = append(resource.StateUpgraders, schema.StateUpgrader{Version: version})
+	}
+	if r.Importer {
+		resource.Importer = &schema.ResourceImporter{}
+	}
+	if r.Timeouts != nil {
+		resource.Timeouts = &schema.ResourceTimeout{
+			Create:  parseDuration(r.Timeouts.Create),
+			Read:    parseDuration(r.Timeouts.Read),
+			Update:  parseDuration(r.Timeouts.Update),
+			Delete:  parseDuration(r.Timeouts.Delete),
+			Default: parseDuration(r.Timeouts.Default),
+		}
+	}
+	for name, field := range r.Schema {
+		resource.Schema[name] = field.schema()
+	}
+	return resource
+}
+
+func newField(s *schema.Schema) *Field {
+	field := &Field{
+		Type:                  s.Type.String(),
+		Required:              s.Required,
+		Optional:              s.Optional,
+		Computed:              s.Computed,
+		ForceNew:              s.ForceNew,
+		DiffSuppressOnRefresh: s.DiffSuppressOnRefresh,
+		Default:               s.Default,
+		Description:           s.Description,
+		InputDefault:          s.InputDefault,
+		MaxItems:              s.MaxItems,
+		MinItems:              s.MinItems,
+		Deprecated:            s.Deprecated,
+		Sensitive:             s.Sensitive,
+		ConflictsWith:         s.ConflictsWith,
+		ExactlyOneOf:          s.ExactlyOneOf,
+		AtLeastOneOf:          s.AtLeastOneOf,
+		RequiredWith:          s.RequiredWith,
+		HasDiffSuppressFunc:   s.DiffSuppressFunc != nil,
+		HasDefaultFunc:        s.DefaultFunc != nil,
+		HasStateFunc:          s.StateFunc != nil,
+		HasSetFunc:            s.Set != nil,
+		HasValidateFunc:       s.ValidateFunc != nil,
+		HasValidateDiagFunc:   s.ValidateDiagFunc != nil,
+	}
+	switch s.ConfigMode {
+	case schema.SchemaConfigModeAttr:
+		field.ConfigMode = "attr"
+	case schema.SchemaConfigModeBlock:
+		field.ConfigMode = "block"
+	}
+	switch elem := s.Elem.(type) {
+	case *schema.Schema:
+		field.ElemField = newField(elem)
+	case *schema.Resource:
+		field.ElemResource = newResource(elem)
+	}
+	return field
+}
+
+func (f *Field) schema() *schema.Schema {
+	s := &schema.Schema{
+		Type:                  valueType(f.Type),
+		Required:              f.Required,
+		Optional:              f.Optional,
+		Computed:              f.Computed,
+		ForceNew:              f.ForceNew,
+		DiffSuppressOnRefresh: f.DiffSuppressOnRefresh,
+		Description:           f.Description,
+		InputDefault:          f.InputDefault,
+		MaxItems:              f.MaxItems,
+		MinItems:              f.MinItems,
+		Deprecated:            f.Deprecated,
+		Sensitive:             f.Sensitive,
+		ConflictsWith:         f.ConflictsWith,
+		ExactlyOneOf:          f.ExactlyOneOf,
+		AtLeastOneOf:          f.AtLeastOneOf,
+		RequiredWith:          f.RequiredWith,
+	}
+	s.Default = defaultValue(s.Type, f.Default)
+	switch f.ConfigMode {
+	case "attr":
+		s.ConfigMode = schema.SchemaConfigModeAttr
+	case "block":
+		s.ConfigMode = schema.SchemaConfigModeBlock
+	}
+	if f.ElemField != nil {
+		s.Elem = f.ElemField.schema()
+	} else if f.ElemResource != nil {
+		s.Elem = f.ElemResource.resource()
+	}
+	// The placeholders are never called: diffs only check whether functions are set.
+	if f.HasDiffSuppressFunc {
+		s.DiffSuppressFunc = func(string, string, string, *schema.ResourceData) bool { return false }
+	}
+	if f.HasDefaultFunc {
+		s.DefaultFunc = func() (interface{}, error) { return nil, nil }
+	}
+	if f.HasStateFunc {
+		s.StateFunc = func(interface{}) string { return "" }
+	}
+	if f.HasSetFunc {
+		s.Set = func(interface{}) int { return 0 }
+	}
+	if f.HasValidateFunc {
+		s.ValidateFunc = func(interface{}, string) ([]string, []error) { return nil, nil }
+	}
+	if f.HasValidateDiagFunc {
+		s.ValidateDiagFunc = func(interface{}, cty.Path) diag.Diagnostics { return nil }
+	}
+	return s
+}
+
+// valueType returns the schema type named name (like "TypeString").
+func valueType(name string) schema.ValueType {
+	for t := schema.TypeInvalid; t <= schema.TypeSet; t++ {
+		if t.String() == name {
+			return t
+		}
+	}
+	return schema.TypeInvalid
+}
+
+// defaultValue converts a default decoded from JSON back to the Go type of values of
+// fields of type t, so that it compares equal to the default of the provider schema.
+func defaultValue(t schema.ValueType, value interface{}) interface{} {
+	n, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+	if t == schema.TypeInt {
+		if i, err := n.Int64(); err == nil {
+			return int(i)
+		}
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+	return n.String()
+}
+
+func formatDuration(d *time.Duration) string {
+	if d == nil {
+		return ""
+	}
+	return d.String()
+}
+
+func parseDuration(s string) *time.Duration {
+	if s == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return nil
+	}
+	return &d
+}
+
+func newFunction(f *tfprotov5.Function) (*Function, error) {
+	function := &Function{
+		Summary:            f.Summary,
+		Description:        f.Description,
+		DeprecationMessage: f.DeprecationMessage,
+	}
+	if f.Return != nil {
+		t, err := marshalType(f.Return.Type)
+		if err != nil {
+			return nil, fmt.Errorf("return type: %w", err)
+		}
+		function.ReturnType = t
+	}
+	for _, p := range f.Parameters {
+		param, err := newFunctionParam(p)
+		if err != nil {
+			return nil, err
+		}
+		function.Parameters = append(function.Parameters, param)
+	}
+	if f.VariadicParameter != nil {
+		param, err := newFunctionParam(f.VariadicParameter)
+		if err != nil {
+			return nil, err
+		}
+		function.VariadicParameter = param
+	}
+	return function, nil
+}
+
+func (f *Function) function() (*tfprotov5.Function, error) {
+	function := &tfprotov5.Function{
+		Summary:            f.Summary,
+		Description:        f.Description,
+		DeprecationMessage: f.DeprecationMessage,
+	}
+	if f.ReturnType != nil {
+		t, err := parseType(f.ReturnType)
+		if err != nil {
+			return nil, fmt.Errorf("return type: %w", err)
+		}
+		function.Return = &tfprotov5.FunctionReturn{Type: t}
+	}
+	for _, p := range f.Parameters {
+		param, err := p.parameter()
+		if err != nil {
+			return nil, err
+		}
+		function.Parameters = append(function.Parameters, param)
+	}
+	if f.VariadicParameter != nil {
+		param, err := f.VariadicParameter.parameter()
+		if err != nil {
+			return nil, err
+		}
+		function.VariadicParameter = param
+	}
+	return function, nil
+}
+
+func newFunctionParam(p *tfprotov5.FunctionParameter) (*FunctionParam, error) {
+	t, err := marshalType(p.Type)
+	if err != nil {
+		return nil, fmt.Errorf("parameter %s: %w", p.Name, err)
+	}
+	return &FunctionParam{
+		Name:               p.Name,
+		Description:        p.Description,
+		Type:               t,
+		IsNullable:         p.AllowNullValue,
+		AllowUnknownValues: p.AllowUnknownValues,
+	}, nil
+}
+
+func (p *FunctionParam) parameter() (*tfprotov5.FunctionParameter, error) {
+	param := &tfprotov5.FunctionParameter{
+		Name:               p.Name,
+		Description:        p.Description,
+		AllowNullValue:     p.IsNullable,
+		AllowUnknownValues: p.AllowUnknownValues,
+	}
+	if p.Type != nil {
+		t, err := parseType(p.Type)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", p.Name, err)
+		}
+		param.Type = t
+	}
+	return param, nil
+}
+
+// marshalType and parseType use the tftypes JSON encoding, which is deprecated for
+// providers but is the format of the types written by Terraform.
+func marshalType(t tftypes.Type) (json.RawMessage, error) {
+	if t == nil {
+		return nil, nil
+	}
+	return t.MarshalJSON()
+}
+
+func parseType(b json.RawMessage) (tftypes.Type, error) {
+	return tftypes.ParseJSONType(b)
+}
@@ -0,0 +1,210 @@
+package snapshot
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/metadata"
+)
+
+func testProviderSchema() diff.ProviderSchema {
+	timeout := 20 * time.Minute
+	return diff.ProviderSchema{
+		Provider: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"project": {Type: schema.TypeString, Optional: true, DefaultFunc: schema.EnvDefaultFunc("GOOGLE_PROJECT", nil)},
+			},
+		},
+		Resources: map[string]*schema.Resource{
+			"google_pubsub_topic": {
+				SchemaVersion:  1,
+				StateUpgraders: []schema.StateUpgrader{{Version: 0}},
+				Importer:       &schema.ResourceImporter{},
+				Timeouts:       &schema.ResourceTimeout{Create: &timeout, Delete: &timeout},
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ForceNew:         true,
+						DiffSuppressFunc: func(string, string, string, *schema.ResourceData) bool { return false },
+					},
+					"message_retention_duration": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Default:       "604800s",
+						ConflictsWith: []string{"message_storage_policy"},
+					},
+					"partition_count": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: func(interface{}, string) ([]string, []error) { return nil, nil },
+					},
+					"throughput": {
+						Type:     schema.TypeFloat,
+						Optional: true,
+						Default:  1.5,
+					},
+					"labels": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"message_storage_policy": {
+						Type:       schema.TypeList,
+						Optional:   true,
+						MaxItems:   1,
+						ConfigMode: schema.SchemaConfigModeAttr,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"allowed_persistence_regions": {
+									Type:     schema.TypeSet,
+									Required: true,
+									Elem:     &schema.Schema{Type: schema.TypeString},
+									Set:      schema.HashString,
+								},
+							},
+						},
+					},
+				},
+			},
+			"google_framework_resource": {
+				SchemaVersion: 1,
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Required: true},
+				},
+			},
+		},
+		ProtocolResources: map[string]bool{"google_framework_resource": true},
+		DataSources: map[string]*schema.Resource{
+			"google_pubsub_topic": {
+				DeprecationMessage: "Use google_pubsub_topics instead.",
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Required: true, Deprecated: "Use id instead."},
+				},
+			},
+		},
+		EphemeralResources: map[string]*schema.Resource{
+			"google_service_account_access_token": {
+				Schema: map[string]*schema.Schema{
+					"access_token": {Type: schema.TypeString, Computed: true, Sensitive: true},
+				},
+			},
+		},
+		Functions: map[string]*tfprotov5.Function{
+			"project_from_id": {
+				Summary: "Returns the project within a provided resource id.",
+				Parameters: []*tfprotov5.FunctionParameter{
+					{Name: "id", Type: tftypes.String},
+				},
+				VariadicParameter: &tfprotov5.FunctionParameter{Name: "rest", Type: tftypes.List{ElementType: tftypes.String}, AllowNullValue: true},
+				Return:            &tfprotov5.FunctionReturn{Type: tftypes.String},
+			},
+		},
+		ResourceMetadata: map[string]*metadata.Resource{
+			"google_pubsub_topic": {
+				Resource:       "google_pubsub_topic",
+				GenerationType: "mmv1",
+				SourceFile:     "products/pubsub/Topic.yaml",
+				ApiServiceName: "pubsub.googleapis.com",
+				Fields: []metadata.Field{
+					{Field: "name", Constraints: metadata.Constraints{ValidationRegex: "^[a-z]+$"}},
+				},
+			},
+		},
+	}
+}
+
+func TestWriteParse(t *testing.T) {
+	want := testProviderSchema()
+	var buf bytes.Buffer
+	if err := Write(&buf, want); err != nil {
+		t.Fatalf("Write() error: %v", err)
+	}
+	got, err := Parse(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	schemaDiff := diff.ComputeProviderSchemaDiff(want, got)
+	if len(schemaDiff.Provider.Fields) > 0 || len(schemaDiff.Resources) > 0 || len(schemaDiff.DataSources) > 0 ||
+		len(schemaDiff.EphemeralResources) > 0 || len(schemaDiff.Functions) > 0 {
+		t.Errorf("Parse(Write()) differs from the original schema: %+v", schemaDiff)
+	}
+	if diff := cmp.Diff(want.ResourceMetadata, got.ResourceMetadata); diff != "" {
+		t.Errorf("Parse(Write()) unexpected metadata diff (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want.ProtocolResources, got.ProtocolResources); diff != "" {
+		t.Errorf("Parse(Write()) unexpected protocol resources diff (-want, +got):\n%s", diff)
+	}
+
+	// Snapshots of terraform schemas keep their source.
+	want.Source = diff.SchemaSourceTerraform
+	buf.Reset()
+	if err := Write(&buf, want); err != nil {
+		t.Fatalf("Write() error: %v", err)
+	}
+	if got, err = Parse(buf.Bytes()); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if got.Source != diff.SchemaSourceTerraform {
+		t.Errorf("Parse(Write()) source = %q, want %q", got.Source, diff.SchemaSourceTerraform)
+	}
+
+	// Defaults are compared with ==, so they must keep their Go type.
+	fields := got.Resources["google_pubsub_topic"].Schema
+	for name, wantDefault := range map[string]interface{}{
+		"message_retention_duration": "604800s",
+		"partition_count":            1,
+		"throughput":                 1.5,
+	} {
+		if fields[name].Default != wantDefault {
+			t.Errorf("Parse(Write()) default of %s = %#v, want %#v", name, fields[name].Default, wantDefault)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:    "invalid json",
+			input:   "{",
+			wantErr: "error parsing schema",
+		},
+		{
+			name:    "unsupported format version",
+			input:   `{"format_version": "2"}`,
+			wantErr: "unsupported snapshot format version",
+		},
+		{
+			name:    "no google provider",
+			input:   `{"format_version": "1.0", "provider_schemas": {"registry.terraform.io/hashicorp/random": {}}}`,
+			wantErr: "expected the schema of exactly one Google provider",
+		},
+		{
+			name: "several google providers",
+			input: `{"format_version": "1.0", "provider_schemas": {
+				"registry.terraform.io/hashicorp/google": {},
+				"registry.terraform.io/hashicorp/google-beta": {}
+			}}`,
+			wantErr: "expected the schema of exactly one Google provider",
+		},
+	} {
+		_, err := Parse([]byte(test.input))
+		if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+			t.Errorf("test %s: Parse() error = %v, want an error containing %q", test.name, err, test.wantErr)
+		}
+	}
+}
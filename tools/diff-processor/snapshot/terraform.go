@@ -0,0 +1,165 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+)
+
+// providerTypes are the types of the providers read from the output of
+// `terraform providers schema -json`, which holds the schemas of every provider
+// used by the configuration.
+var providerTypes = map[string]bool{
+	"google":      true,
+	"google-beta": true,
+}
+
+// terraformProviderSchema is the schema of a provider in the output of
+// `terraform providers schema -json`.
+type terraformProviderSchema struct {
+	Provider                 *terraformSchema            `json:"provider"`
+	ResourceSchemas          map[string]*terraformSchema `json:"resource_schemas"`
+	DataSourceSchemas        map[string]*terraformSchema `json:"data_source_schemas"`
+	EphemeralResourceSchemas map[string]*terraformSchema `json:"ephemeral_resource_schemas"`
+	Functions                map[string]*Function        `json:"functions"`
+}
+
+type terraformSchema struct {
+	Version int64           `json:"version"`
+	Block   *terraformBlock `json:"block"`
+}
+
+type terraformBlock struct {
+	Attributes  map[string]*terraformAttribute `json:"attributes"`
+	BlockTypes  map[string]*terraformBlockType `json:"block_types"`
+	Description string                         `json:"description"`
+	Deprecated  bool                           `json:"deprecated"`
+}
+
+type terraformAttribute struct {
+	Type        json.RawMessage `json:"type"`
+	Description string          `json:"description"`
+	Required    bool            `json:"required"`
+	Optional    bool            `json:"optional"`
+	Computed    bool            `json:"computed"`
+	Sensitive   bool            `json:"sensitive"`
+	Deprecated  bool            `json:"deprecated"`
+}
+
+type terraformBlockType struct {
+	NestingMode string          `json:"nesting_mode"`
+	Block       *terraformBlock `json:"block"`
+	MinItems    int64           `json:"min_items"`
+	MaxItems    int64           `json:"max_items"`
+}
+
+var terraformNestingModes = map[string]tfprotov5.SchemaNestedBlockNestingMode{
+	"single": tfprotov5.SchemaNestedBlockNestingModeSingle,
+	"group":  tfprotov5.SchemaNestedBlockNestingModeGroup,
+	"list":   tfprotov5.SchemaNestedBlockNestingModeList,
+	"set":    tfprotov5.SchemaNestedBlockNestingModeSet,
+	"map":    tfprotov5.SchemaNestedBlockNestingModeMap,
+}
+
+// parseTerraformSchemas returns the schema of the Google provider from the
+// provider_schemas of the output of `terraform providers schema -json`. It's converted
+// the same way as the schemas of plugin framework resources, so it lacks the details
+// only SDKv2 schemas have (ForceNew, defaults, field sets...), and has no metadata.
+func parseTerraformSchemas(b json.RawMessage) (diff.ProviderSchema, error) {
+	var providerSchemas map[string]*terraformProviderSchema
+	if err := json.Unmarshal(b, &providerSchemas); err != nil {
+		return diff.ProviderSchema{}, fmt.Errorf("error parsing provider schemas: %w", err)
+	}
+	var addresses []string
+	for address := range providerSchemas {
+		if providerTypes[path.Base(address)] {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) != 1 {
+		sort.Strings(addresses)
+		return diff.ProviderSchema{}, fmt.Errorf("expected the schema of exactly one Google provider, found %q", addresses)
+	}
+	tfSchema := providerSchemas[addresses[0]]
+
+	providerSchema := diff.ProviderSchema{
+		Provider:           diff.ResourceFromProtocolSchema(tfSchema.Provider.protocolSchema()),
+		Resources:          terraformResources(tfSchema.ResourceSchemas),
+		DataSources:        terraformResources(tfSchema.DataSourceSchemas),
+		EphemeralResources: terraformResources(tfSchema.EphemeralResourceSchemas),
+		ProtocolResources:  make(map[string]bool, len(tfSchema.ResourceSchemas)),
+		Source:             diff.SchemaSourceTerraform,
+	}
+	for name := range tfSchema.ResourceSchemas {
+		providerSchema.ProtocolResources[name] = true
+	}
+	if len(tfSchema.Functions) > 0 {
+		providerSchema.Functions = make(map[string]*tfprotov5.Function, len(tfSchema.Functions))
+		for name, f := range tfSchema.Functions {
+			function, err := f.function()
+			if err != nil {
+				return diff.ProviderSchema{}, fmt.Errorf("function %s: %w", name, err)
+			}
+			providerSchema.Functions[name] = function
+		}
+	}
+	return providerSchema, nil
+}
+
+func terraformResources(schemas map[string]*terraformSchema) map[string]*schema.Resource {
+	resources := make(map[string]*schema.Resource, len(schemas))
+	for name, s := range schemas {
+		resources[name] = diff.ResourceFromProtocolSchema(s.protocolSchema())
+	}
+	return resources
+}
+
+func (s *terraformSchema) protocolSchema() *tfprotov5.Schema {
+	if s == nil {
+		return nil
+	}
+	return &tfprotov5.Schema{Version: s.Version, Block: s.Block.protocolBlock()}
+}
+
+// protocolBlock converts a block to its protocol schema. Attributes whose type can't be
+// parsed are kept without a type, which is converted to schema.TypeInvalid.
+func (b *terraformBlock) protocolBlock() *tfprotov5.SchemaBlock {
+	if b == nil {
+		return &tfprotov5.SchemaBlock{}
+	}
+	block := &tfprotov5.SchemaBlock{
+		Description: b.Description,
+		Deprecated:  b.Deprecated,
+	}
+	for name, attr := range b.Attributes {
+		attribute := &tfprotov5.SchemaAttribute{
+			Name:        name,
+			Description: attr.Description,
+			Required:    attr.Required,
+			Optional:    attr.Optional,
+			Computed:    attr.Computed,
+			Sensitive:   attr.Sensitive,
+			Deprecated:  attr.Deprecated,
+		}
+		if attr.Type != nil {
+			attribute.Type, _ = parseType(attr.Type)
+		}
+		block.Attributes = append(block.Attributes, attribute)
+	}
+	for name, blockType := range b.BlockTypes {
+		block.BlockTypes = append(block.BlockTypes, &tfprotov5.SchemaNestedBlock{
+			TypeName: name,
+			Block:    blockType.Block.protocolBlock(),
+			Nesting:  terraformNestingModes[blockType.NestingMode],
+			MinItems: blockType.MinItems,
+			MaxItems: blockType.MaxItems,
+		})
+	}
+	return block
+}
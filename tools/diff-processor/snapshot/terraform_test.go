@@ -0,0 +1,91 @@
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/GoogleCloudPlatform/magic-modules/tools/diff-processor/diff"
+)
+
+func TestReadTerraformSchema(t *testing.T) {
+	got, err := Read("../testdata/snapshot/terraform_providers_schema.json")
+	if err != nil {
+		t.Fatalf("Read() error: %v", err)
+	}
+
+	wantResources := map[string]*schema.Resource{
+		"google_pubsub_topic": {
+			Schema: map[string]*schema.Schema{
+				"id": {Type: schema.TypeString, Optional: true, Computed: true},
+				"labels": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "A set of key/value label pairs to assign to this Topic.",
+				},
+				"name": {Type: schema.TypeString, Required: true, Description: "Name of the topic."},
+				"message_storage_policy": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Policy constraining the set of Google Cloud Platform regions where messages published to the topic may be stored.",
+					Elem: &schema.Resource{
+						Description: "Policy constraining the set of Google Cloud Platform regions where messages published to the topic may be stored.",
+						Schema: map[string]*schema.Schema{
+							"allowed_persistence_regions": {
+								Type:     schema.TypeSet,
+								Required: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"timeouts": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"create": {Type: schema.TypeString, Optional: true},
+						},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(wantResources, got.Resources); diff != "" {
+		t.Errorf("Read() unexpected resources diff (-want, +got):\n%s", diff)
+	}
+	if got.Source != diff.SchemaSourceTerraform {
+		t.Errorf("Read() source = %q, want %q", got.Source, diff.SchemaSourceTerraform)
+	}
+	if want := map[string]bool{"google_pubsub_topic": true}; !cmp.Equal(want, got.ProtocolResources) {
+		t.Errorf("Read() protocol resources = %v, want %v", got.ProtocolResources, want)
+	}
+	if _, ok := got.DataSources["google_pubsub_topic"]; !ok {
+		t.Errorf("Read() data sources = %v, want google_pubsub_topic", got.DataSources)
+	}
+	if _, ok := got.Provider.Schema["project"]; !ok {
+		t.Errorf("Read() provider schema = %v, want project", got.Provider.Schema)
+	}
+
+	wantFunction := &tfprotov5.Function{
+		Summary:     "Returns the project within a provided resource id, self link, or OP style resource name.",
+		Description: "Returns the project within a provided resource's id, resource URI, self link, or full resource name.",
+		Parameters: []*tfprotov5.FunctionParameter{
+			{
+				Name:        "id",
+				Description: "A string of a resource's id, resource URI, self link, or full resource name.",
+				Type:        tftypes.String,
+			},
+		},
+		Return: &tfprotov5.FunctionReturn{Type: tftypes.String},
+	}
+	if diff := cmp.Diff(wantFunction, got.Functions["project_from_id"]); diff != "" {
+		t.Errorf("Read() unexpected function diff (-want, +got):\n%s", diff)
+	}
+}